@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: call_audit/audit_result.proto
+
+package call_audit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuditResultService_Search_FullMethodName = "/call_audit.AuditResultService/Search"
+	AuditResultService_Get_FullMethodName    = "/call_audit.AuditResultService/Get"
+)
+
+// AuditResultServiceClient is the client API for AuditResultService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service definition
+type AuditResultServiceClient interface {
+	Search(ctx context.Context, in *SearchAuditResultsRequest, opts ...grpc.CallOption) (*AuditResultList, error)
+	Get(ctx context.Context, in *GetAuditResultRequest, opts ...grpc.CallOption) (*AuditResult, error)
+}
+
+type auditResultServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditResultServiceClient(cc grpc.ClientConnInterface) AuditResultServiceClient {
+	return &auditResultServiceClient{cc}
+}
+
+func (c *auditResultServiceClient) Search(ctx context.Context, in *SearchAuditResultsRequest, opts ...grpc.CallOption) (*AuditResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditResultList)
+	err := c.cc.Invoke(ctx, AuditResultService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditResultServiceClient) Get(ctx context.Context, in *GetAuditResultRequest, opts ...grpc.CallOption) (*AuditResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditResult)
+	err := c.cc.Invoke(ctx, AuditResultService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditResultServiceServer is the server API for AuditResultService service.
+// All implementations must embed UnimplementedAuditResultServiceServer
+// for forward compatibility.
+//
+// Service definition
+type AuditResultServiceServer interface {
+	Search(context.Context, *SearchAuditResultsRequest) (*AuditResultList, error)
+	Get(context.Context, *GetAuditResultRequest) (*AuditResult, error)
+	mustEmbedUnimplementedAuditResultServiceServer()
+}
+
+// UnimplementedAuditResultServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditResultServiceServer struct{}
+
+func (UnimplementedAuditResultServiceServer) Search(context.Context, *SearchAuditResultsRequest) (*AuditResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedAuditResultServiceServer) Get(context.Context, *GetAuditResultRequest) (*AuditResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAuditResultServiceServer) mustEmbedUnimplementedAuditResultServiceServer() {}
+func (UnimplementedAuditResultServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAuditResultServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditResultServiceServer will
+// result in compilation errors.
+type UnsafeAuditResultServiceServer interface {
+	mustEmbedUnimplementedAuditResultServiceServer()
+}
+
+func RegisterAuditResultServiceServer(s grpc.ServiceRegistrar, srv AuditResultServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditResultServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditResultService_ServiceDesc, srv)
+}
+
+func _AuditResultService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditResultServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditResultService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditResultServiceServer).Search(ctx, req.(*SearchAuditResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditResultService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditResultServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditResultService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditResultServiceServer).Get(ctx, req.(*GetAuditResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditResultService_ServiceDesc is the grpc.ServiceDesc for AuditResultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditResultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "call_audit.AuditResultService",
+	HandlerType: (*AuditResultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _AuditResultService_Search_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AuditResultService_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "call_audit/audit_result.proto",
+}
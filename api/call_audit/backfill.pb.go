@@ -0,0 +1,836 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: call_audit/backfill.proto
+
+package call_audit
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enum: BackfillState
+// running <-> paused, running | paused -> cancelled, running -> completed
+type BackfillState int32
+
+const (
+	BackfillState_BACKFILL_STATE_RUNNING   BackfillState = 0
+	BackfillState_BACKFILL_STATE_PAUSED    BackfillState = 1
+	BackfillState_BACKFILL_STATE_CANCELLED BackfillState = 2
+	BackfillState_BACKFILL_STATE_COMPLETED BackfillState = 3
+)
+
+// Enum value maps for BackfillState.
+var (
+	BackfillState_name = map[int32]string{
+		0: "BACKFILL_STATE_RUNNING",
+		1: "BACKFILL_STATE_PAUSED",
+		2: "BACKFILL_STATE_CANCELLED",
+		3: "BACKFILL_STATE_COMPLETED",
+	}
+	BackfillState_value = map[string]int32{
+		"BACKFILL_STATE_RUNNING":   0,
+		"BACKFILL_STATE_PAUSED":    1,
+		"BACKFILL_STATE_CANCELLED": 2,
+		"BACKFILL_STATE_COMPLETED": 3,
+	}
+)
+
+func (x BackfillState) Enum() *BackfillState {
+	p := new(BackfillState)
+	*p = x
+	return p
+}
+
+func (x BackfillState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackfillState) Descriptor() protoreflect.EnumDescriptor {
+	return file_call_audit_backfill_proto_enumTypes[0].Descriptor()
+}
+
+func (BackfillState) Type() protoreflect.EnumType {
+	return &file_call_audit_backfill_proto_enumTypes[0]
+}
+
+func (x BackfillState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackfillState.Descriptor instead.
+func (BackfillState) EnumDescriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{0}
+}
+
+// Message: Backfill
+// Audit of the past calls of a rule, queued in throttled batches
+type Backfill struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule  *Lookup                `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	State BackfillState          `protobuf:"varint,5,opt,name=state,proto3,enum=call_audit.BackfillState" json:"state,omitempty"`
+	// number of unfinished jobs kept queued
+	BatchSize int32 `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// calls matching the rule in the window when the backfill was created
+	Total int64 `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	// jobs created so far
+	Queued int64 `protobuf:"varint,8,opt,name=queued,proto3" json:"queued,omitempty"`
+	// jobs succeeded
+	Done int64 `protobuf:"varint,9,opt,name=done,proto3" json:"done,omitempty"`
+	// jobs failed or dead
+	Failed int64 `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	// jobs waiting or running
+	Pending       int64                  `protobuf:"varint,11,opt,name=pending,proto3" json:"pending,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     *Lookup                `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Backfill) Reset() {
+	*x = Backfill{}
+	mi := &file_call_audit_backfill_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Backfill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backfill) ProtoMessage() {}
+
+func (x *Backfill) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backfill.ProtoReflect.Descriptor instead.
+func (*Backfill) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Backfill) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Backfill) GetRule() *Lookup {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *Backfill) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Backfill) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Backfill) GetState() BackfillState {
+	if x != nil {
+		return x.State
+	}
+	return BackfillState_BACKFILL_STATE_RUNNING
+}
+
+func (x *Backfill) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Backfill) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Backfill) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *Backfill) GetDone() int64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *Backfill) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *Backfill) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *Backfill) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Backfill) GetCreatedBy() *Lookup {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *Backfill) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Message: BackfillList
+type BackfillList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Backfill            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillList) Reset() {
+	*x = BackfillList{}
+	mi := &file_call_audit_backfill_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillList) ProtoMessage() {}
+
+func (x *BackfillList) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillList.ProtoReflect.Descriptor instead.
+func (*BackfillList) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BackfillList) GetItems() []*Backfill {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BackfillList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *BackfillList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+// Message: CreateBackfillRequest
+type CreateBackfillRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RuleId int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// 50 when empty
+	BatchSize     int32    `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Fields        []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBackfillRequest) Reset() {
+	*x = CreateBackfillRequest{}
+	mi := &file_call_audit_backfill_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBackfillRequest) ProtoMessage() {}
+
+func (x *CreateBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBackfillRequest.ProtoReflect.Descriptor instead.
+func (*CreateBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBackfillRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *CreateBackfillRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CreateBackfillRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CreateBackfillRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *CreateBackfillRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: SearchBackfillsRequest
+type SearchBackfillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Id            []int64                `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	RuleId        []int64                `protobuf:"varint,6,rep,packed,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	State         []BackfillState        `protobuf:"varint,7,rep,packed,name=state,proto3,enum=call_audit.BackfillState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBackfillsRequest) Reset() {
+	*x = SearchBackfillsRequest{}
+	mi := &file_call_audit_backfill_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBackfillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBackfillsRequest) ProtoMessage() {}
+
+func (x *SearchBackfillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBackfillsRequest.ProtoReflect.Descriptor instead.
+func (*SearchBackfillsRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchBackfillsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchBackfillsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchBackfillsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchBackfillsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchBackfillsRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SearchBackfillsRequest) GetRuleId() []int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *SearchBackfillsRequest) GetState() []BackfillState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// Message: GetBackfillRequest
+type GetBackfillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBackfillRequest) Reset() {
+	*x = GetBackfillRequest{}
+	mi := &file_call_audit_backfill_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackfillRequest) ProtoMessage() {}
+
+func (x *GetBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackfillRequest.ProtoReflect.Descriptor instead.
+func (*GetBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBackfillRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetBackfillRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: PauseBackfillRequest
+// Stops queuing new batches, the queued jobs are finished
+type PauseBackfillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseBackfillRequest) Reset() {
+	*x = PauseBackfillRequest{}
+	mi := &file_call_audit_backfill_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseBackfillRequest) ProtoMessage() {}
+
+func (x *PauseBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseBackfillRequest.ProtoReflect.Descriptor instead.
+func (*PauseBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PauseBackfillRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PauseBackfillRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: ResumeBackfillRequest
+type ResumeBackfillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeBackfillRequest) Reset() {
+	*x = ResumeBackfillRequest{}
+	mi := &file_call_audit_backfill_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeBackfillRequest) ProtoMessage() {}
+
+func (x *ResumeBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeBackfillRequest.ProtoReflect.Descriptor instead.
+func (*ResumeBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResumeBackfillRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResumeBackfillRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: CancelBackfillRequest
+// Stops the backfill and cancels its waiting jobs
+type CancelBackfillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBackfillRequest) Reset() {
+	*x = CancelBackfillRequest{}
+	mi := &file_call_audit_backfill_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBackfillRequest) ProtoMessage() {}
+
+func (x *CancelBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_backfill_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBackfillRequest.ProtoReflect.Descriptor instead.
+func (*CancelBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_backfill_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelBackfillRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelBackfillRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_call_audit_backfill_proto protoreflect.FileDescriptor
+
+const file_call_audit_backfill_proto_rawDesc = "" +
+	"\n" +
+	"\x19call_audit/backfill.proto\x12\n" +
+	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18call_audit/general.proto\x1a\x18call_audit/options.proto\"\x8b\x04\n" +
+	"\bBackfill\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x04rule\x18\x02 \x01(\v2\x12.call_audit.LookupR\x04rule\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12/\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x19.call_audit.BackfillStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x06 \x01(\x05R\tbatchSize\x12\x14\n" +
+	"\x05total\x18\a \x01(\x03R\x05total\x12\x16\n" +
+	"\x06queued\x18\b \x01(\x03R\x06queued\x12\x12\n" +
+	"\x04done\x18\t \x01(\x03R\x04done\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\x03R\x06failed\x12\x18\n" +
+	"\apending\x18\v \x01(\x03R\apending\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x121\n" +
+	"\n" +
+	"created_by\x18\r \x01(\v2\x12.call_audit.LookupR\tcreatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"b\n" +
+	"\fBackfillList\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.call_audit.BackfillR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x03 \x01(\bR\x04next\"\xc3\x01\n" +
+	"\x15CreateBackfillRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\"\xc6\x01\n" +
+	"\x16SearchBackfillsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x12\x0e\n" +
+	"\x02id\x18\x05 \x03(\x03R\x02id\x12\x17\n" +
+	"\arule_id\x18\x06 \x03(\x03R\x06ruleId\x12/\n" +
+	"\x05state\x18\a \x03(\x0e2\x19.call_audit.BackfillStateR\x05state\"<\n" +
+	"\x12GetBackfillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\">\n" +
+	"\x14PauseBackfillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"?\n" +
+	"\x15ResumeBackfillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"?\n" +
+	"\x15CancelBackfillRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields*\x82\x01\n" +
+	"\rBackfillState\x12\x1a\n" +
+	"\x16BACKFILL_STATE_RUNNING\x10\x00\x12\x19\n" +
+	"\x15BACKFILL_STATE_PAUSED\x10\x01\x12\x1c\n" +
+	"\x18BACKFILL_STATE_CANCELLED\x10\x02\x12\x1c\n" +
+	"\x18BACKFILL_STATE_COMPLETED\x10\x032\xde\x03\n" +
+	"\x0fBackfillService\x12G\n" +
+	"\x06Create\x12!.call_audit.CreateBackfillRequest\x1a\x14.call_audit.Backfill\"\x04\x88\xb5\x18\x00\x12L\n" +
+	"\x06Search\x12\".call_audit.SearchBackfillsRequest\x1a\x18.call_audit.BackfillList\"\x04\x88\xb5\x18\x01\x12A\n" +
+	"\x03Get\x12\x1e.call_audit.GetBackfillRequest\x1a\x14.call_audit.Backfill\"\x04\x88\xb5\x18\x01\x12E\n" +
+	"\x05Pause\x12 .call_audit.PauseBackfillRequest\x1a\x14.call_audit.Backfill\"\x04\x88\xb5\x18\x02\x12G\n" +
+	"\x06Resume\x12!.call_audit.ResumeBackfillRequest\x1a\x14.call_audit.Backfill\"\x04\x88\xb5\x18\x02\x12G\n" +
+	"\x06Cancel\x12!.call_audit.CancelBackfillRequest\x1a\x14.call_audit.Backfill\"\x04\x88\xb5\x18\x02\x1a\x18\x8a\xb5\x18\x14call_audit_backfillsB\x91\x01\n" +
+	"\x0ecom.call_auditB\rBackfillProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+
+var (
+	file_call_audit_backfill_proto_rawDescOnce sync.Once
+	file_call_audit_backfill_proto_rawDescData []byte
+)
+
+func file_call_audit_backfill_proto_rawDescGZIP() []byte {
+	file_call_audit_backfill_proto_rawDescOnce.Do(func() {
+		file_call_audit_backfill_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_call_audit_backfill_proto_rawDesc), len(file_call_audit_backfill_proto_rawDesc)))
+	})
+	return file_call_audit_backfill_proto_rawDescData
+}
+
+var file_call_audit_backfill_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_call_audit_backfill_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_call_audit_backfill_proto_goTypes = []any{
+	(BackfillState)(0),             // 0: call_audit.BackfillState
+	(*Backfill)(nil),               // 1: call_audit.Backfill
+	(*BackfillList)(nil),           // 2: call_audit.BackfillList
+	(*CreateBackfillRequest)(nil),  // 3: call_audit.CreateBackfillRequest
+	(*SearchBackfillsRequest)(nil), // 4: call_audit.SearchBackfillsRequest
+	(*GetBackfillRequest)(nil),     // 5: call_audit.GetBackfillRequest
+	(*PauseBackfillRequest)(nil),   // 6: call_audit.PauseBackfillRequest
+	(*ResumeBackfillRequest)(nil),  // 7: call_audit.ResumeBackfillRequest
+	(*CancelBackfillRequest)(nil),  // 8: call_audit.CancelBackfillRequest
+	(*Lookup)(nil),                 // 9: call_audit.Lookup
+	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
+}
+var file_call_audit_backfill_proto_depIdxs = []int32{
+	9,  // 0: call_audit.Backfill.rule:type_name -> call_audit.Lookup
+	10, // 1: call_audit.Backfill.from:type_name -> google.protobuf.Timestamp
+	10, // 2: call_audit.Backfill.to:type_name -> google.protobuf.Timestamp
+	0,  // 3: call_audit.Backfill.state:type_name -> call_audit.BackfillState
+	10, // 4: call_audit.Backfill.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 5: call_audit.Backfill.created_by:type_name -> call_audit.Lookup
+	10, // 6: call_audit.Backfill.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: call_audit.BackfillList.items:type_name -> call_audit.Backfill
+	10, // 8: call_audit.CreateBackfillRequest.from:type_name -> google.protobuf.Timestamp
+	10, // 9: call_audit.CreateBackfillRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 10: call_audit.SearchBackfillsRequest.state:type_name -> call_audit.BackfillState
+	3,  // 11: call_audit.BackfillService.Create:input_type -> call_audit.CreateBackfillRequest
+	4,  // 12: call_audit.BackfillService.Search:input_type -> call_audit.SearchBackfillsRequest
+	5,  // 13: call_audit.BackfillService.Get:input_type -> call_audit.GetBackfillRequest
+	6,  // 14: call_audit.BackfillService.Pause:input_type -> call_audit.PauseBackfillRequest
+	7,  // 15: call_audit.BackfillService.Resume:input_type -> call_audit.ResumeBackfillRequest
+	8,  // 16: call_audit.BackfillService.Cancel:input_type -> call_audit.CancelBackfillRequest
+	1,  // 17: call_audit.BackfillService.Create:output_type -> call_audit.Backfill
+	2,  // 18: call_audit.BackfillService.Search:output_type -> call_audit.BackfillList
+	1,  // 19: call_audit.BackfillService.Get:output_type -> call_audit.Backfill
+	1,  // 20: call_audit.BackfillService.Pause:output_type -> call_audit.Backfill
+	1,  // 21: call_audit.BackfillService.Resume:output_type -> call_audit.Backfill
+	1,  // 22: call_audit.BackfillService.Cancel:output_type -> call_audit.Backfill
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_call_audit_backfill_proto_init() }
+func file_call_audit_backfill_proto_init() {
+	if File_call_audit_backfill_proto != nil {
+		return
+	}
+	file_call_audit_general_proto_init()
+	file_call_audit_options_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_audit_backfill_proto_rawDesc), len(file_call_audit_backfill_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_call_audit_backfill_proto_goTypes,
+		DependencyIndexes: file_call_audit_backfill_proto_depIdxs,
+		EnumInfos:         file_call_audit_backfill_proto_enumTypes,
+		MessageInfos:      file_call_audit_backfill_proto_msgTypes,
+	}.Build()
+	File_call_audit_backfill_proto = out.File
+	file_call_audit_backfill_proto_goTypes = nil
+	file_call_audit_backfill_proto_depIdxs = nil
+}
@@ -14,7 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	_ "google.golang.org/protobuf/types/known/wrapperspb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -24,6 +24,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Enum: ChunkStrategy
+type ChunkStrategy int32
+
+const (
+	// a dialogue above max_tokens is split into chunks, every chunk is summarized or evaluated
+	// and the results of the chunks are reduced into the final summary or scorecard answers
+	ChunkStrategy_CHUNK_STRATEGY_MAP_REDUCE ChunkStrategy = 0
+	// the whole dialogue is sent in a single request, the model may truncate a long one
+	ChunkStrategy_CHUNK_STRATEGY_NONE ChunkStrategy = 1
+)
+
+// Enum value maps for ChunkStrategy.
+var (
+	ChunkStrategy_name = map[int32]string{
+		0: "CHUNK_STRATEGY_MAP_REDUCE",
+		1: "CHUNK_STRATEGY_NONE",
+	}
+	ChunkStrategy_value = map[string]int32{
+		"CHUNK_STRATEGY_MAP_REDUCE": 0,
+		"CHUNK_STRATEGY_NONE":       1,
+	}
+)
+
+func (x ChunkStrategy) Enum() *ChunkStrategy {
+	p := new(ChunkStrategy)
+	*p = x
+	return p
+}
+
+func (x ChunkStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChunkStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_call_audit_call_questionnaire_rule_proto_enumTypes[0].Descriptor()
+}
+
+func (ChunkStrategy) Type() protoreflect.EnumType {
+	return &file_call_audit_call_questionnaire_rule_proto_enumTypes[0]
+}
+
+func (x ChunkStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChunkStrategy.Descriptor instead.
+func (ChunkStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{0}
+}
+
 // Message: CallQuestionnaireRule
 type CallQuestionnaireRule struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -46,8 +96,42 @@ type CallQuestionnaireRule struct {
 	DefaultPromt     string                 `protobuf:"bytes,17,opt,name=default_promt,json=defaultPromt,proto3" json:"default_promt,omitempty"`
 	SaveExplanation  bool                   `protobuf:"varint,18,opt,name=save_explanation,json=saveExplanation,proto3" json:"save_explanation,omitempty"`
 	LastStoredAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=last_stored_at,json=lastStoredAt,proto3" json:"last_stored_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// LLM model name, provider default when empty
+	Model           string                  `protobuf:"bytes,20,opt,name=model,proto3" json:"model,omitempty"`
+	Temperature     *wrapperspb.DoubleValue `protobuf:"bytes,21,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	TopP            *wrapperspb.DoubleValue `protobuf:"bytes,22,opt,name=top_p,json=topP,proto3" json:"top_p,omitempty"`
+	MaxOutputTokens *wrapperspb.Int32Value  `protobuf:"bytes,23,opt,name=max_output_tokens,json=maxOutputTokens,proto3" json:"max_output_tokens,omitempty"`
+	Seed            *wrapperspb.Int64Value  `protobuf:"bytes,24,opt,name=seed,proto3" json:"seed,omitempty"`
+	// low, medium or high; only for reasoning models
+	ReasoningEffort string `protobuf:"bytes,25,opt,name=reasoning_effort,json=reasoningEffort,proto3" json:"reasoning_effort,omitempty"`
+	// audit form used to rate the calls, summary only when empty
+	Scorecard *Lookup `protobuf:"bytes,26,opt,name=scorecard,proto3" json:"scorecard,omitempty"`
+	// row version, incremented on every update
+	Ver int32 `protobuf:"varint,27,opt,name=ver,proto3" json:"ver,omitempty"`
+	// opaque id and version, send it back on Update, Patch and Delete to reject stale writes
+	Etag string `protobuf:"bytes,28,opt,name=etag,proto3" json:"etag,omitempty"`
+	// conditions of the calls audited by the rule besides the direction and the talk duration
+	CallFilter *CallFilter `protobuf:"bytes,29,opt,name=call_filter,json=callFilter,proto3" json:"call_filter,omitempty"`
+	// percent of the matching calls audited, the same calls are picked on every poll; all calls when empty.
+	// Sampling and the limits apply to the polled calls, not to backfills and manual audits
+	SamplePercent *wrapperspb.Int32Value `protobuf:"bytes,30,opt,name=sample_percent,json=samplePercent,proto3" json:"sample_percent,omitempty"`
+	// audits of the calls of an agent per day and per week, no limit when empty
+	AgentDailyLimit  *wrapperspb.Int32Value `protobuf:"bytes,31,opt,name=agent_daily_limit,json=agentDailyLimit,proto3" json:"agent_daily_limit,omitempty"`
+	AgentWeeklyLimit *wrapperspb.Int32Value `protobuf:"bytes,32,opt,name=agent_weekly_limit,json=agentWeeklyLimit,proto3" json:"agent_weekly_limit,omitempty"`
+	// audits of the rule per day, no limit when empty
+	DailyLimit *wrapperspb.Int32Value `protobuf:"bytes,33,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	// cron spec in the domain timezone, e.g. "0 2 * * *" or "@daily"; the calls stored before its last run are audited.
+	// Every call is audited as soon as it is stored when empty
+	Schedule string `protobuf:"bytes,34,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// weight of the rule from 1 to 100, a rule of priority 2 gets twice the workers of a rule of priority 1
+	// of the same domain; the domains share the workers equally. 1 when empty
+	Priority int32 `protobuf:"varint,35,opt,name=priority,proto3" json:"priority,omitempty"`
+	// why the enabled rule creates no jobs, e.g. the monthly budget of the domain is spent; read only
+	PausedReason string `protobuf:"bytes,36,opt,name=paused_reason,json=pausedReason,proto3" json:"paused_reason,omitempty"`
+	// how a transcript too long for a single request is audited
+	Chunking      *TranscriptChunking `protobuf:"bytes,37,opt,name=chunking,proto3" json:"chunking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CallQuestionnaireRule) Reset() {
@@ -213,30 +297,158 @@ func (x *CallQuestionnaireRule) GetLastStoredAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Message: CallQuestionnaireRuleList
-type CallQuestionnaireRuleList struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Items         []*CallQuestionnaireRule `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Page          int32                    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Next          bool                     `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CallQuestionnaireRule) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
 }
 
-func (x *CallQuestionnaireRuleList) Reset() {
-	*x = CallQuestionnaireRuleList{}
+func (x *CallQuestionnaireRule) GetTemperature() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Temperature
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetTopP() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.TopP
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetMaxOutputTokens() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxOutputTokens
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetSeed() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetReasoningEffort() string {
+	if x != nil {
+		return x.ReasoningEffort
+	}
+	return ""
+}
+
+func (x *CallQuestionnaireRule) GetScorecard() *Lookup {
+	if x != nil {
+		return x.Scorecard
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetVer() int32 {
+	if x != nil {
+		return x.Ver
+	}
+	return 0
+}
+
+func (x *CallQuestionnaireRule) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *CallQuestionnaireRule) GetCallFilter() *CallFilter {
+	if x != nil {
+		return x.CallFilter
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetSamplePercent() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetAgentDailyLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.AgentDailyLimit
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetAgentWeeklyLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.AgentWeeklyLimit
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetDailyLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRule) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CallQuestionnaireRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CallQuestionnaireRule) GetPausedReason() string {
+	if x != nil {
+		return x.PausedReason
+	}
+	return ""
+}
+
+func (x *CallQuestionnaireRule) GetChunking() *TranscriptChunking {
+	if x != nil {
+		return x.Chunking
+	}
+	return nil
+}
+
+// Message: TranscriptChunking
+type TranscriptChunking struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Strategy ChunkStrategy          `protobuf:"varint,1,opt,name=strategy,proto3,enum=call_audit.ChunkStrategy" json:"strategy,omitempty"`
+	// estimated tokens of the dialogue above which it is split, also the size of a chunk; 12000 when empty
+	MaxTokens int32 `protobuf:"varint,2,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// phrases of the end of a chunk repeated at the start of the next one, 0 repeats none; 3 when empty
+	OverlapPhrases *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=overlap_phrases,json=overlapPhrases,proto3" json:"overlap_phrases,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TranscriptChunking) Reset() {
+	*x = TranscriptChunking{}
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallQuestionnaireRuleList) String() string {
+func (x *TranscriptChunking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallQuestionnaireRuleList) ProtoMessage() {}
+func (*TranscriptChunking) ProtoMessage() {}
 
-func (x *CallQuestionnaireRuleList) ProtoReflect() protoreflect.Message {
+func (x *TranscriptChunking) ProtoReflect() protoreflect.Message {
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -248,54 +460,73 @@ func (x *CallQuestionnaireRuleList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallQuestionnaireRuleList.ProtoReflect.Descriptor instead.
-func (*CallQuestionnaireRuleList) Descriptor() ([]byte, []int) {
+// Deprecated: Use TranscriptChunking.ProtoReflect.Descriptor instead.
+func (*TranscriptChunking) Descriptor() ([]byte, []int) {
 	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CallQuestionnaireRuleList) GetItems() []*CallQuestionnaireRule {
+func (x *TranscriptChunking) GetStrategy() ChunkStrategy {
 	if x != nil {
-		return x.Items
+		return x.Strategy
 	}
-	return nil
+	return ChunkStrategy_CHUNK_STRATEGY_MAP_REDUCE
 }
 
-func (x *CallQuestionnaireRuleList) GetPage() int32 {
+func (x *TranscriptChunking) GetMaxTokens() int32 {
 	if x != nil {
-		return x.Page
+		return x.MaxTokens
 	}
 	return 0
 }
 
-func (x *CallQuestionnaireRuleList) GetNext() bool {
+func (x *TranscriptChunking) GetOverlapPhrases() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Next
+		return x.OverlapPhrases
 	}
-	return false
+	return nil
 }
 
-// Message: GetByIdRequest
-type GetCallQuestionnaireRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+// Message: CallFilter
+// Conditions of the stored calls, an empty condition matches any call
+type CallFilter struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	QueueId   []int64                `protobuf:"varint,1,rep,packed,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	TeamId    []int64                `protobuf:"varint,2,rep,packed,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	AgentId   []int64                `protobuf:"varint,3,rep,packed,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	GatewayId []int64                `protobuf:"varint,4,rep,packed,name=gateway_id,json=gatewayId,proto3" json:"gateway_id,omitempty"`
+	// hangup causes, e.g. NORMAL_CLEARING
+	HangupCause []string `protobuf:"bytes,5,rep,name=hangup_cause,json=hangupCause,proto3" json:"hangup_cause,omitempty"`
+	// the call has any of the tags
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// mask of the destination, * matches any characters and ? a single one
+	Destination string `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination,omitempty"`
+	// mask of the from or the to number
+	Number string `protobuf:"bytes,8,opt,name=number,proto3" json:"number,omitempty"`
+	// call variables equal to the values
+	Variables map[string]string `protobuf:"bytes,9,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// total duration of the call in seconds, 0 is no limit
+	MinDuration int32 `protobuf:"varint,10,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration int32 `protobuf:"varint,11,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	// the call was placed within any of the windows in the domain timezone
+	TimeWindows   []*CallTimeWindow `protobuf:"bytes,12,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCallQuestionnaireRuleRequest) Reset() {
-	*x = GetCallQuestionnaireRuleRequest{}
+func (x *CallFilter) Reset() {
+	*x = CallFilter{}
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCallQuestionnaireRuleRequest) String() string {
+func (x *CallFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCallQuestionnaireRuleRequest) ProtoMessage() {}
+func (*CallFilter) ProtoMessage() {}
 
-func (x *GetCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *CallFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -307,40 +538,121 @@ func (x *GetCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
-func (*GetCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CallFilter.ProtoReflect.Descriptor instead.
+func (*CallFilter) Descriptor() ([]byte, []int) {
 	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetCallQuestionnaireRuleRequest) GetId() int32 {
+func (x *CallFilter) GetQueueId() []int64 {
 	if x != nil {
-		return x.Id
+		return x.QueueId
+	}
+	return nil
+}
+
+func (x *CallFilter) GetTeamId() []int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return nil
+}
+
+func (x *CallFilter) GetAgentId() []int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return nil
+}
+
+func (x *CallFilter) GetGatewayId() []int64 {
+	if x != nil {
+		return x.GatewayId
+	}
+	return nil
+}
+
+func (x *CallFilter) GetHangupCause() []string {
+	if x != nil {
+		return x.HangupCause
+	}
+	return nil
+}
+
+func (x *CallFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CallFilter) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *CallFilter) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CallFilter) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CallFilter) GetMinDuration() int32 {
+	if x != nil {
+		return x.MinDuration
 	}
 	return 0
 }
 
-// Message: DeleteByIdRequest
-type DeleteCallQuestionnaireRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *CallFilter) GetMaxDuration() int32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *CallFilter) GetTimeWindows() []*CallTimeWindow {
+	if x != nil {
+		return x.TimeWindows
+	}
+	return nil
+}
+
+// Message: CallTimeWindow
+type CallTimeWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days of the week, 1 is Monday and 7 is Sunday; every day when empty
+	Days []int32 `protobuf:"varint,1,rep,packed,name=days,proto3" json:"days,omitempty"`
+	// minutes since midnight, the start is included and the end is not, e.g. 540 and 1080 for 09:00-18:00
+	StartMinute   int32 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute     int32 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCallQuestionnaireRuleRequest) Reset() {
-	*x = DeleteCallQuestionnaireRuleRequest{}
+func (x *CallTimeWindow) Reset() {
+	*x = CallTimeWindow{}
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCallQuestionnaireRuleRequest) String() string {
+func (x *CallTimeWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCallQuestionnaireRuleRequest) ProtoMessage() {}
+func (*CallTimeWindow) ProtoMessage() {}
 
-func (x *DeleteCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *CallTimeWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,40 +664,56 @@ func (x *DeleteCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CallTimeWindow.ProtoReflect.Descriptor instead.
+func (*CallTimeWindow) Descriptor() ([]byte, []int) {
 	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DeleteCallQuestionnaireRuleRequest) GetId() int32 {
+func (x *CallTimeWindow) GetDays() []int32 {
 	if x != nil {
-		return x.Id
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CallTimeWindow) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
 	}
 	return 0
 }
 
-// Message: Create/Update Request
-type UpsertCallQuestionnaireRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rule          *CallQuestionnaireRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+func (x *CallTimeWindow) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+// Message: CallQuestionnaireRuleList
+type CallQuestionnaireRuleList struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*CallQuestionnaireRule `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                     `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertCallQuestionnaireRuleRequest) Reset() {
-	*x = UpsertCallQuestionnaireRuleRequest{}
+func (x *CallQuestionnaireRuleList) Reset() {
+	*x = CallQuestionnaireRuleList{}
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertCallQuestionnaireRuleRequest) String() string {
+func (x *CallQuestionnaireRuleList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertCallQuestionnaireRuleRequest) ProtoMessage() {}
+func (*CallQuestionnaireRuleList) ProtoMessage() {}
 
-func (x *UpsertCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *CallQuestionnaireRuleList) ProtoReflect() protoreflect.Message {
 	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -397,14 +725,336 @@ func (x *UpsertCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
-func (*UpsertCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CallQuestionnaireRuleList.ProtoReflect.Descriptor instead.
+func (*CallQuestionnaireRuleList) Descriptor() ([]byte, []int) {
 	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpsertCallQuestionnaireRuleRequest) GetRule() *CallQuestionnaireRule {
+func (x *CallQuestionnaireRuleList) GetItems() []*CallQuestionnaireRule {
 	if x != nil {
-		return x.Rule
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CallQuestionnaireRuleList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CallQuestionnaireRuleList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+// Message: ListCallQuestionnaireRulesRequest
+type ListCallQuestionnaireRulesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Page   int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size   int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Q      string                 `protobuf:"bytes,3,opt,name=q,proto3" json:"q,omitempty"`
+	Sort   string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Fields []string               `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	// key=value pairs: enabled, call_direction, language_profile, cognitive_profile, scorecard
+	Filters       []string `protobuf:"bytes,6,rep,name=filters,proto3" json:"filters,omitempty"`
+	Id            []int64  `protobuf:"varint,7,rep,packed,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallQuestionnaireRulesRequest) Reset() {
+	*x = ListCallQuestionnaireRulesRequest{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallQuestionnaireRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallQuestionnaireRulesRequest) ProtoMessage() {}
+
+func (x *ListCallQuestionnaireRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallQuestionnaireRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCallQuestionnaireRulesRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetFilters() []string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ListCallQuestionnaireRulesRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// Message: GetByIdRequest
+type GetCallQuestionnaireRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCallQuestionnaireRuleRequest) Reset() {
+	*x = GetCallQuestionnaireRuleRequest{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallQuestionnaireRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallQuestionnaireRuleRequest) ProtoMessage() {}
+
+func (x *GetCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCallQuestionnaireRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Message: DeleteByIdRequest
+type DeleteCallQuestionnaireRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// optional, rejects the delete when the rule has been changed since
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCallQuestionnaireRuleRequest) Reset() {
+	*x = DeleteCallQuestionnaireRuleRequest{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCallQuestionnaireRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCallQuestionnaireRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteCallQuestionnaireRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCallQuestionnaireRuleRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Message: Create/Update Request
+type UpsertCallQuestionnaireRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *CallQuestionnaireRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertCallQuestionnaireRuleRequest) Reset() {
+	*x = UpsertCallQuestionnaireRuleRequest{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertCallQuestionnaireRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertCallQuestionnaireRuleRequest) ProtoMessage() {}
+
+func (x *UpsertCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpsertCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpsertCallQuestionnaireRuleRequest) GetRule() *CallQuestionnaireRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Message: PatchCallQuestionnaireRuleRequest
+// Only the fields listed in x_json_mask are changed, all fields when the mask is empty
+type PatchCallQuestionnaireRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          *CallQuestionnaireRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	XJsonMask     []string               `protobuf:"bytes,3,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchCallQuestionnaireRuleRequest) Reset() {
+	*x = PatchCallQuestionnaireRuleRequest{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchCallQuestionnaireRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCallQuestionnaireRuleRequest) ProtoMessage() {}
+
+func (x *PatchCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
+func (*PatchCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PatchCallQuestionnaireRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchCallQuestionnaireRuleRequest) GetRule() *CallQuestionnaireRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *PatchCallQuestionnaireRuleRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+func (x *PatchCallQuestionnaireRuleRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
@@ -418,7 +1068,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[5]
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +1080,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[5]
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +1093,213 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{5}
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{10}
+}
+
+// Message: PreviewCallQuestionnaireRuleRequest
+// Lists the calls the rule would audit first, nothing is written
+type PreviewCallQuestionnaireRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unsaved rule, the calls already audited are excluded when id is set
+	Rule *CallQuestionnaireRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// number of matching calls returned, 10 when empty
+	SampleSize int32 `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	// number of the returned calls audited with the rule without writing variables or posting ratings, at most 5
+	Run           int32 `protobuf:"varint,3,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCallQuestionnaireRuleRequest) Reset() {
+	*x = PreviewCallQuestionnaireRuleRequest{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCallQuestionnaireRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCallQuestionnaireRuleRequest) ProtoMessage() {}
+
+func (x *PreviewCallQuestionnaireRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCallQuestionnaireRuleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCallQuestionnaireRuleRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreviewCallQuestionnaireRuleRequest) GetRule() *CallQuestionnaireRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *PreviewCallQuestionnaireRuleRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *PreviewCallQuestionnaireRuleRequest) GetRun() int32 {
+	if x != nil {
+		return x.Run
+	}
+	return 0
+}
+
+// Message: PreviewCall
+type PreviewCall struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CallId    string                 `protobuf:"bytes,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	StoredAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=stored_at,json=storedAt,proto3" json:"stored_at,omitempty"`
+	Direction string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	TalkSec   int32                  `protobuf:"varint,4,opt,name=talk_sec,json=talkSec,proto3" json:"talk_sec,omitempty"`
+	// output of the rule, only for the audited calls
+	Result *AuditResult `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	// reason the audit of the call failed
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCall) Reset() {
+	*x = PreviewCall{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCall) ProtoMessage() {}
+
+func (x *PreviewCall) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCall.ProtoReflect.Descriptor instead.
+func (*PreviewCall) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PreviewCall) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *PreviewCall) GetStoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoredAt
+	}
+	return nil
+}
+
+func (x *PreviewCall) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *PreviewCall) GetTalkSec() int32 {
+	if x != nil {
+		return x.TalkSec
+	}
+	return 0
+}
+
+func (x *PreviewCall) GetResult() *AuditResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *PreviewCall) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Message: PreviewCallQuestionnaireRuleResponse
+type PreviewCallQuestionnaireRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// number of calls matching the rule
+	Count         int64          `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*PreviewCall `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCallQuestionnaireRuleResponse) Reset() {
+	*x = PreviewCallQuestionnaireRuleResponse{}
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCallQuestionnaireRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCallQuestionnaireRuleResponse) ProtoMessage() {}
+
+func (x *PreviewCallQuestionnaireRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_call_questionnaire_rule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCallQuestionnaireRuleResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCallQuestionnaireRuleResponse) Descriptor() ([]byte, []int) {
+	return file_call_audit_call_questionnaire_rule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreviewCallQuestionnaireRuleResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PreviewCallQuestionnaireRuleResponse) GetItems() []*PreviewCall {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
 var File_call_audit_call_questionnaire_rule_proto protoreflect.FileDescriptor
@@ -451,7 +1307,7 @@ var File_call_audit_call_questionnaire_rule_proto protoreflect.FileDescriptor
 const file_call_audit_call_questionnaire_rule_proto_rawDesc = "" +
 	"\n" +
 	"(call_audit/call_questionnaire_rule.proto\x12\n" +
-	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x18call_audit/general.proto\"\xcd\x06\n" +
+	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x18call_audit/general.proto\x1a\x1dcall_audit/audit_result.proto\x1a\x18call_audit/options.proto\"\xbb\r\n" +
 	"\x15CallQuestionnaireRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\x129\n" +
@@ -476,25 +1332,108 @@ const file_call_audit_call_questionnaire_rule_proto_rawDesc = "" +
 	"\bvariable\x18\x10 \x01(\tR\bvariable\x12#\n" +
 	"\rdefault_promt\x18\x11 \x01(\tR\fdefaultPromt\x12)\n" +
 	"\x10save_explanation\x18\x12 \x01(\bR\x0fsaveExplanation\x12@\n" +
-	"\x0elast_stored_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\flastStoredAt\"|\n" +
+	"\x0elast_stored_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\flastStoredAt\x12\x14\n" +
+	"\x05model\x18\x14 \x01(\tR\x05model\x12>\n" +
+	"\vtemperature\x18\x15 \x01(\v2\x1c.google.protobuf.DoubleValueR\vtemperature\x121\n" +
+	"\x05top_p\x18\x16 \x01(\v2\x1c.google.protobuf.DoubleValueR\x04topP\x12G\n" +
+	"\x11max_output_tokens\x18\x17 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fmaxOutputTokens\x12/\n" +
+	"\x04seed\x18\x18 \x01(\v2\x1b.google.protobuf.Int64ValueR\x04seed\x12)\n" +
+	"\x10reasoning_effort\x18\x19 \x01(\tR\x0freasoningEffort\x120\n" +
+	"\tscorecard\x18\x1a \x01(\v2\x12.call_audit.LookupR\tscorecard\x12\x10\n" +
+	"\x03ver\x18\x1b \x01(\x05R\x03ver\x12\x12\n" +
+	"\x04etag\x18\x1c \x01(\tR\x04etag\x127\n" +
+	"\vcall_filter\x18\x1d \x01(\v2\x16.call_audit.CallFilterR\n" +
+	"callFilter\x12B\n" +
+	"\x0esample_percent\x18\x1e \x01(\v2\x1b.google.protobuf.Int32ValueR\rsamplePercent\x12G\n" +
+	"\x11agent_daily_limit\x18\x1f \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fagentDailyLimit\x12I\n" +
+	"\x12agent_weekly_limit\x18  \x01(\v2\x1b.google.protobuf.Int32ValueR\x10agentWeeklyLimit\x12<\n" +
+	"\vdaily_limit\x18! \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"dailyLimit\x12\x1a\n" +
+	"\bschedule\x18\" \x01(\tR\bschedule\x12\x1a\n" +
+	"\bpriority\x18# \x01(\x05R\bpriority\x12#\n" +
+	"\rpaused_reason\x18$ \x01(\tR\fpausedReason\x12:\n" +
+	"\bchunking\x18% \x01(\v2\x1e.call_audit.TranscriptChunkingR\bchunking\"\xb0\x01\n" +
+	"\x12TranscriptChunking\x125\n" +
+	"\bstrategy\x18\x01 \x01(\x0e2\x19.call_audit.ChunkStrategyR\bstrategy\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x02 \x01(\x05R\tmaxTokens\x12D\n" +
+	"\x0foverlap_phrases\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0eoverlapPhrases\"\xf3\x03\n" +
+	"\n" +
+	"CallFilter\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x03(\x03R\aqueueId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x03(\x03R\x06teamId\x12\x19\n" +
+	"\bagent_id\x18\x03 \x03(\x03R\aagentId\x12\x1d\n" +
+	"\n" +
+	"gateway_id\x18\x04 \x03(\x03R\tgatewayId\x12!\n" +
+	"\fhangup_cause\x18\x05 \x03(\tR\vhangupCause\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12 \n" +
+	"\vdestination\x18\a \x01(\tR\vdestination\x12\x16\n" +
+	"\x06number\x18\b \x01(\tR\x06number\x12C\n" +
+	"\tvariables\x18\t \x03(\v2%.call_audit.CallFilter.VariablesEntryR\tvariables\x12!\n" +
+	"\fmin_duration\x18\n" +
+	" \x01(\x05R\vminDuration\x12!\n" +
+	"\fmax_duration\x18\v \x01(\x05R\vmaxDuration\x12=\n" +
+	"\ftime_windows\x18\f \x03(\v2\x1a.call_audit.CallTimeWindowR\vtimeWindows\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x0eCallTimeWindow\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\x05R\x04days\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\x05R\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\x05R\tendMinute\"|\n" +
 	"\x19CallQuestionnaireRuleList\x127\n" +
 	"\x05items\x18\x01 \x03(\v2!.call_audit.CallQuestionnaireRuleR\x05items\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04next\x18\x03 \x01(\bR\x04next\"1\n" +
+	"\x04next\x18\x03 \x01(\bR\x04next\"\xaf\x01\n" +
+	"!ListCallQuestionnaireRulesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\f\n" +
+	"\x01q\x18\x03 \x01(\tR\x01q\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\x12\x18\n" +
+	"\afilters\x18\x06 \x03(\tR\afilters\x12\x0e\n" +
+	"\x02id\x18\a \x03(\x03R\x02id\"1\n" +
 	"\x1fGetCallQuestionnaireRuleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"H\n" +
 	"\"DeleteCallQuestionnaireRuleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"[\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"[\n" +
 	"\"UpsertCallQuestionnaireRuleRequest\x125\n" +
-	"\x04rule\x18\x01 \x01(\v2!.call_audit.CallQuestionnaireRuleR\x04rule\"\a\n" +
-	"\x05Empty2\xce\x03\n" +
+	"\x04rule\x18\x01 \x01(\v2!.call_audit.CallQuestionnaireRuleR\x04rule\"\xa2\x01\n" +
+	"!PatchCallQuestionnaireRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
+	"\x04rule\x18\x02 \x01(\v2!.call_audit.CallQuestionnaireRuleR\x04rule\x12\x1e\n" +
+	"\vx_json_mask\x18\x03 \x03(\tR\txJsonMask\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\"\a\n" +
+	"\x05Empty\"\x8f\x01\n" +
+	"#PreviewCallQuestionnaireRuleRequest\x125\n" +
+	"\x04rule\x18\x01 \x01(\v2!.call_audit.CallQuestionnaireRuleR\x04rule\x12\x1f\n" +
+	"\vsample_size\x18\x02 \x01(\x05R\n" +
+	"sampleSize\x12\x10\n" +
+	"\x03run\x18\x03 \x01(\x05R\x03run\"\xdf\x01\n" +
+	"\vPreviewCall\x12\x17\n" +
+	"\acall_id\x18\x01 \x01(\tR\x06callId\x127\n" +
+	"\tstored_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstoredAt\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x19\n" +
+	"\btalk_sec\x18\x04 \x01(\x05R\atalkSec\x12/\n" +
+	"\x06result\x18\x05 \x01(\v2\x17.call_audit.AuditResultR\x06result\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"k\n" +
+	"$PreviewCallQuestionnaireRuleResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.call_audit.PreviewCallR\x05items*G\n" +
+	"\rChunkStrategy\x12\x1d\n" +
+	"\x19CHUNK_STRATEGY_MAP_REDUCE\x10\x00\x12\x17\n" +
+	"\x13CHUNK_STRATEGY_NONE\x10\x012\xc3\x05\n" +
 	"\x1cCallQuestionnaireRuleService\x12U\n" +
-	"\x03Get\x12+.call_audit.GetCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\x12@\n" +
-	"\x04List\x12\x11.call_audit.Empty\x1a%.call_audit.CallQuestionnaireRuleList\x12[\n" +
+	"\x03Get\x12+.call_audit.GetCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\x12\\\n" +
+	"\x04List\x12-.call_audit.ListCallQuestionnaireRulesRequest\x1a%.call_audit.CallQuestionnaireRuleList\x12[\n" +
 	"\x06Create\x12..call_audit.UpsertCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\x12[\n" +
-	"\x06Update\x12..call_audit.UpsertCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\x12[\n" +
-	"\x06Delete\x12..call_audit.DeleteCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRuleB\xa4\x01\n" +
-	"\x0ecom.call_auditB\x1aCallQuestionnaireRuleProtoP\x01Z2github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+	"\x06Update\x12..call_audit.UpsertCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\x12_\n" +
+	"\x05Patch\x12-.call_audit.PatchCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\"\x04\x88\xb5\x18\x02\x12[\n" +
+	"\x06Delete\x12..call_audit.DeleteCallQuestionnaireRuleRequest\x1a!.call_audit.CallQuestionnaireRule\x12v\n" +
+	"\vPreviewRule\x12/.call_audit.PreviewCallQuestionnaireRuleRequest\x1a0.call_audit.PreviewCallQuestionnaireRuleResponse\"\x04\x88\xb5\x18\x02B\x9e\x01\n" +
+	"\x0ecom.call_auditB\x1aCallQuestionnaireRuleProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
 
 var (
 	file_call_audit_call_questionnaire_rule_proto_rawDescOnce sync.Once
@@ -508,44 +1447,83 @@ func file_call_audit_call_questionnaire_rule_proto_rawDescGZIP() []byte {
 	return file_call_audit_call_questionnaire_rule_proto_rawDescData
 }
 
-var file_call_audit_call_questionnaire_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_call_audit_call_questionnaire_rule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_call_audit_call_questionnaire_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_call_audit_call_questionnaire_rule_proto_goTypes = []any{
-	(*CallQuestionnaireRule)(nil),              // 0: call_audit.CallQuestionnaireRule
-	(*CallQuestionnaireRuleList)(nil),          // 1: call_audit.CallQuestionnaireRuleList
-	(*GetCallQuestionnaireRuleRequest)(nil),    // 2: call_audit.GetCallQuestionnaireRuleRequest
-	(*DeleteCallQuestionnaireRuleRequest)(nil), // 3: call_audit.DeleteCallQuestionnaireRuleRequest
-	(*UpsertCallQuestionnaireRuleRequest)(nil), // 4: call_audit.UpsertCallQuestionnaireRuleRequest
-	(*Empty)(nil),                 // 5: call_audit.Empty
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
-	(*Lookup)(nil),                // 7: call_audit.Lookup
+	(ChunkStrategy)(0),                           // 0: call_audit.ChunkStrategy
+	(*CallQuestionnaireRule)(nil),                // 1: call_audit.CallQuestionnaireRule
+	(*TranscriptChunking)(nil),                   // 2: call_audit.TranscriptChunking
+	(*CallFilter)(nil),                           // 3: call_audit.CallFilter
+	(*CallTimeWindow)(nil),                       // 4: call_audit.CallTimeWindow
+	(*CallQuestionnaireRuleList)(nil),            // 5: call_audit.CallQuestionnaireRuleList
+	(*ListCallQuestionnaireRulesRequest)(nil),    // 6: call_audit.ListCallQuestionnaireRulesRequest
+	(*GetCallQuestionnaireRuleRequest)(nil),      // 7: call_audit.GetCallQuestionnaireRuleRequest
+	(*DeleteCallQuestionnaireRuleRequest)(nil),   // 8: call_audit.DeleteCallQuestionnaireRuleRequest
+	(*UpsertCallQuestionnaireRuleRequest)(nil),   // 9: call_audit.UpsertCallQuestionnaireRuleRequest
+	(*PatchCallQuestionnaireRuleRequest)(nil),    // 10: call_audit.PatchCallQuestionnaireRuleRequest
+	(*Empty)(nil),                                // 11: call_audit.Empty
+	(*PreviewCallQuestionnaireRuleRequest)(nil),  // 12: call_audit.PreviewCallQuestionnaireRuleRequest
+	(*PreviewCall)(nil),                          // 13: call_audit.PreviewCall
+	(*PreviewCallQuestionnaireRuleResponse)(nil), // 14: call_audit.PreviewCallQuestionnaireRuleResponse
+	nil,                            // 15: call_audit.CallFilter.VariablesEntry
+	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
+	(*Lookup)(nil),                 // 17: call_audit.Lookup
+	(*wrapperspb.DoubleValue)(nil), // 18: google.protobuf.DoubleValue
+	(*wrapperspb.Int32Value)(nil),  // 19: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),  // 20: google.protobuf.Int64Value
+	(*AuditResult)(nil),            // 21: call_audit.AuditResult
 }
 var file_call_audit_call_questionnaire_rule_proto_depIdxs = []int32{
-	6,  // 0: call_audit.CallQuestionnaireRule.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 1: call_audit.CallQuestionnaireRule.created_by:type_name -> call_audit.Lookup
-	6,  // 2: call_audit.CallQuestionnaireRule.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 3: call_audit.CallQuestionnaireRule.updated_by:type_name -> call_audit.Lookup
-	7,  // 4: call_audit.CallQuestionnaireRule.language_profile:type_name -> call_audit.Lookup
-	7,  // 5: call_audit.CallQuestionnaireRule.cognitive_profile:type_name -> call_audit.Lookup
-	6,  // 6: call_audit.CallQuestionnaireRule.from:type_name -> google.protobuf.Timestamp
-	6,  // 7: call_audit.CallQuestionnaireRule.to:type_name -> google.protobuf.Timestamp
-	6,  // 8: call_audit.CallQuestionnaireRule.last_stored_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: call_audit.CallQuestionnaireRuleList.items:type_name -> call_audit.CallQuestionnaireRule
-	0,  // 10: call_audit.UpsertCallQuestionnaireRuleRequest.rule:type_name -> call_audit.CallQuestionnaireRule
-	2,  // 11: call_audit.CallQuestionnaireRuleService.Get:input_type -> call_audit.GetCallQuestionnaireRuleRequest
-	5,  // 12: call_audit.CallQuestionnaireRuleService.List:input_type -> call_audit.Empty
-	4,  // 13: call_audit.CallQuestionnaireRuleService.Create:input_type -> call_audit.UpsertCallQuestionnaireRuleRequest
-	4,  // 14: call_audit.CallQuestionnaireRuleService.Update:input_type -> call_audit.UpsertCallQuestionnaireRuleRequest
-	3,  // 15: call_audit.CallQuestionnaireRuleService.Delete:input_type -> call_audit.DeleteCallQuestionnaireRuleRequest
-	0,  // 16: call_audit.CallQuestionnaireRuleService.Get:output_type -> call_audit.CallQuestionnaireRule
-	1,  // 17: call_audit.CallQuestionnaireRuleService.List:output_type -> call_audit.CallQuestionnaireRuleList
-	0,  // 18: call_audit.CallQuestionnaireRuleService.Create:output_type -> call_audit.CallQuestionnaireRule
-	0,  // 19: call_audit.CallQuestionnaireRuleService.Update:output_type -> call_audit.CallQuestionnaireRule
-	0,  // 20: call_audit.CallQuestionnaireRuleService.Delete:output_type -> call_audit.CallQuestionnaireRule
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	16, // 0: call_audit.CallQuestionnaireRule.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: call_audit.CallQuestionnaireRule.created_by:type_name -> call_audit.Lookup
+	16, // 2: call_audit.CallQuestionnaireRule.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 3: call_audit.CallQuestionnaireRule.updated_by:type_name -> call_audit.Lookup
+	17, // 4: call_audit.CallQuestionnaireRule.language_profile:type_name -> call_audit.Lookup
+	17, // 5: call_audit.CallQuestionnaireRule.cognitive_profile:type_name -> call_audit.Lookup
+	16, // 6: call_audit.CallQuestionnaireRule.from:type_name -> google.protobuf.Timestamp
+	16, // 7: call_audit.CallQuestionnaireRule.to:type_name -> google.protobuf.Timestamp
+	16, // 8: call_audit.CallQuestionnaireRule.last_stored_at:type_name -> google.protobuf.Timestamp
+	18, // 9: call_audit.CallQuestionnaireRule.temperature:type_name -> google.protobuf.DoubleValue
+	18, // 10: call_audit.CallQuestionnaireRule.top_p:type_name -> google.protobuf.DoubleValue
+	19, // 11: call_audit.CallQuestionnaireRule.max_output_tokens:type_name -> google.protobuf.Int32Value
+	20, // 12: call_audit.CallQuestionnaireRule.seed:type_name -> google.protobuf.Int64Value
+	17, // 13: call_audit.CallQuestionnaireRule.scorecard:type_name -> call_audit.Lookup
+	3,  // 14: call_audit.CallQuestionnaireRule.call_filter:type_name -> call_audit.CallFilter
+	19, // 15: call_audit.CallQuestionnaireRule.sample_percent:type_name -> google.protobuf.Int32Value
+	19, // 16: call_audit.CallQuestionnaireRule.agent_daily_limit:type_name -> google.protobuf.Int32Value
+	19, // 17: call_audit.CallQuestionnaireRule.agent_weekly_limit:type_name -> google.protobuf.Int32Value
+	19, // 18: call_audit.CallQuestionnaireRule.daily_limit:type_name -> google.protobuf.Int32Value
+	2,  // 19: call_audit.CallQuestionnaireRule.chunking:type_name -> call_audit.TranscriptChunking
+	0,  // 20: call_audit.TranscriptChunking.strategy:type_name -> call_audit.ChunkStrategy
+	19, // 21: call_audit.TranscriptChunking.overlap_phrases:type_name -> google.protobuf.Int32Value
+	15, // 22: call_audit.CallFilter.variables:type_name -> call_audit.CallFilter.VariablesEntry
+	4,  // 23: call_audit.CallFilter.time_windows:type_name -> call_audit.CallTimeWindow
+	1,  // 24: call_audit.CallQuestionnaireRuleList.items:type_name -> call_audit.CallQuestionnaireRule
+	1,  // 25: call_audit.UpsertCallQuestionnaireRuleRequest.rule:type_name -> call_audit.CallQuestionnaireRule
+	1,  // 26: call_audit.PatchCallQuestionnaireRuleRequest.rule:type_name -> call_audit.CallQuestionnaireRule
+	1,  // 27: call_audit.PreviewCallQuestionnaireRuleRequest.rule:type_name -> call_audit.CallQuestionnaireRule
+	16, // 28: call_audit.PreviewCall.stored_at:type_name -> google.protobuf.Timestamp
+	21, // 29: call_audit.PreviewCall.result:type_name -> call_audit.AuditResult
+	13, // 30: call_audit.PreviewCallQuestionnaireRuleResponse.items:type_name -> call_audit.PreviewCall
+	7,  // 31: call_audit.CallQuestionnaireRuleService.Get:input_type -> call_audit.GetCallQuestionnaireRuleRequest
+	6,  // 32: call_audit.CallQuestionnaireRuleService.List:input_type -> call_audit.ListCallQuestionnaireRulesRequest
+	9,  // 33: call_audit.CallQuestionnaireRuleService.Create:input_type -> call_audit.UpsertCallQuestionnaireRuleRequest
+	9,  // 34: call_audit.CallQuestionnaireRuleService.Update:input_type -> call_audit.UpsertCallQuestionnaireRuleRequest
+	10, // 35: call_audit.CallQuestionnaireRuleService.Patch:input_type -> call_audit.PatchCallQuestionnaireRuleRequest
+	8,  // 36: call_audit.CallQuestionnaireRuleService.Delete:input_type -> call_audit.DeleteCallQuestionnaireRuleRequest
+	12, // 37: call_audit.CallQuestionnaireRuleService.PreviewRule:input_type -> call_audit.PreviewCallQuestionnaireRuleRequest
+	1,  // 38: call_audit.CallQuestionnaireRuleService.Get:output_type -> call_audit.CallQuestionnaireRule
+	5,  // 39: call_audit.CallQuestionnaireRuleService.List:output_type -> call_audit.CallQuestionnaireRuleList
+	1,  // 40: call_audit.CallQuestionnaireRuleService.Create:output_type -> call_audit.CallQuestionnaireRule
+	1,  // 41: call_audit.CallQuestionnaireRuleService.Update:output_type -> call_audit.CallQuestionnaireRule
+	1,  // 42: call_audit.CallQuestionnaireRuleService.Patch:output_type -> call_audit.CallQuestionnaireRule
+	1,  // 43: call_audit.CallQuestionnaireRuleService.Delete:output_type -> call_audit.CallQuestionnaireRule
+	14, // 44: call_audit.CallQuestionnaireRuleService.PreviewRule:output_type -> call_audit.PreviewCallQuestionnaireRuleResponse
+	38, // [38:45] is the sub-list for method output_type
+	31, // [31:38] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_call_audit_call_questionnaire_rule_proto_init() }
@@ -554,18 +1532,21 @@ func file_call_audit_call_questionnaire_rule_proto_init() {
 		return
 	}
 	file_call_audit_general_proto_init()
+	file_call_audit_audit_result_proto_init()
+	file_call_audit_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_audit_call_questionnaire_rule_proto_rawDesc), len(file_call_audit_call_questionnaire_rule_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_call_audit_call_questionnaire_rule_proto_goTypes,
 		DependencyIndexes: file_call_audit_call_questionnaire_rule_proto_depIdxs,
+		EnumInfos:         file_call_audit_call_questionnaire_rule_proto_enumTypes,
 		MessageInfos:      file_call_audit_call_questionnaire_rule_proto_msgTypes,
 	}.Build()
 	File_call_audit_call_questionnaire_rule_proto = out.File
@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CallQuestionnaireRuleService_Get_FullMethodName    = "/call_audit.CallQuestionnaireRuleService/Get"
-	CallQuestionnaireRuleService_List_FullMethodName   = "/call_audit.CallQuestionnaireRuleService/List"
-	CallQuestionnaireRuleService_Create_FullMethodName = "/call_audit.CallQuestionnaireRuleService/Create"
-	CallQuestionnaireRuleService_Update_FullMethodName = "/call_audit.CallQuestionnaireRuleService/Update"
-	CallQuestionnaireRuleService_Delete_FullMethodName = "/call_audit.CallQuestionnaireRuleService/Delete"
+	CallQuestionnaireRuleService_Get_FullMethodName         = "/call_audit.CallQuestionnaireRuleService/Get"
+	CallQuestionnaireRuleService_List_FullMethodName        = "/call_audit.CallQuestionnaireRuleService/List"
+	CallQuestionnaireRuleService_Create_FullMethodName      = "/call_audit.CallQuestionnaireRuleService/Create"
+	CallQuestionnaireRuleService_Update_FullMethodName      = "/call_audit.CallQuestionnaireRuleService/Update"
+	CallQuestionnaireRuleService_Patch_FullMethodName       = "/call_audit.CallQuestionnaireRuleService/Patch"
+	CallQuestionnaireRuleService_Delete_FullMethodName      = "/call_audit.CallQuestionnaireRuleService/Delete"
+	CallQuestionnaireRuleService_PreviewRule_FullMethodName = "/call_audit.CallQuestionnaireRuleService/PreviewRule"
 )
 
 // CallQuestionnaireRuleServiceClient is the client API for CallQuestionnaireRuleService service.
@@ -33,10 +35,13 @@ const (
 // Service definition
 type CallQuestionnaireRuleServiceClient interface {
 	Get(ctx context.Context, in *GetCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error)
-	List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CallQuestionnaireRuleList, error)
+	List(ctx context.Context, in *ListCallQuestionnaireRulesRequest, opts ...grpc.CallOption) (*CallQuestionnaireRuleList, error)
 	Create(ctx context.Context, in *UpsertCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error)
 	Update(ctx context.Context, in *UpsertCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error)
+	Patch(ctx context.Context, in *PatchCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error)
 	Delete(ctx context.Context, in *DeleteCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error)
+	// the audited run spends the LLM budget of the domain
+	PreviewRule(ctx context.Context, in *PreviewCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*PreviewCallQuestionnaireRuleResponse, error)
 }
 
 type callQuestionnaireRuleServiceClient struct {
@@ -57,7 +62,7 @@ func (c *callQuestionnaireRuleServiceClient) Get(ctx context.Context, in *GetCal
 	return out, nil
 }
 
-func (c *callQuestionnaireRuleServiceClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CallQuestionnaireRuleList, error) {
+func (c *callQuestionnaireRuleServiceClient) List(ctx context.Context, in *ListCallQuestionnaireRulesRequest, opts ...grpc.CallOption) (*CallQuestionnaireRuleList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CallQuestionnaireRuleList)
 	err := c.cc.Invoke(ctx, CallQuestionnaireRuleService_List_FullMethodName, in, out, cOpts...)
@@ -87,6 +92,16 @@ func (c *callQuestionnaireRuleServiceClient) Update(ctx context.Context, in *Ups
 	return out, nil
 }
 
+func (c *callQuestionnaireRuleServiceClient) Patch(ctx context.Context, in *PatchCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallQuestionnaireRule)
+	err := c.cc.Invoke(ctx, CallQuestionnaireRuleService_Patch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *callQuestionnaireRuleServiceClient) Delete(ctx context.Context, in *DeleteCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*CallQuestionnaireRule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CallQuestionnaireRule)
@@ -97,6 +112,16 @@ func (c *callQuestionnaireRuleServiceClient) Delete(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *callQuestionnaireRuleServiceClient) PreviewRule(ctx context.Context, in *PreviewCallQuestionnaireRuleRequest, opts ...grpc.CallOption) (*PreviewCallQuestionnaireRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCallQuestionnaireRuleResponse)
+	err := c.cc.Invoke(ctx, CallQuestionnaireRuleService_PreviewRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CallQuestionnaireRuleServiceServer is the server API for CallQuestionnaireRuleService service.
 // All implementations must embed UnimplementedCallQuestionnaireRuleServiceServer
 // for forward compatibility.
@@ -104,10 +129,13 @@ func (c *callQuestionnaireRuleServiceClient) Delete(ctx context.Context, in *Del
 // Service definition
 type CallQuestionnaireRuleServiceServer interface {
 	Get(context.Context, *GetCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error)
-	List(context.Context, *Empty) (*CallQuestionnaireRuleList, error)
+	List(context.Context, *ListCallQuestionnaireRulesRequest) (*CallQuestionnaireRuleList, error)
 	Create(context.Context, *UpsertCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error)
 	Update(context.Context, *UpsertCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error)
+	Patch(context.Context, *PatchCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error)
 	Delete(context.Context, *DeleteCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error)
+	// the audited run spends the LLM budget of the domain
+	PreviewRule(context.Context, *PreviewCallQuestionnaireRuleRequest) (*PreviewCallQuestionnaireRuleResponse, error)
 	mustEmbedUnimplementedCallQuestionnaireRuleServiceServer()
 }
 
@@ -121,7 +149,7 @@ type UnimplementedCallQuestionnaireRuleServiceServer struct{}
 func (UnimplementedCallQuestionnaireRuleServiceServer) Get(context.Context, *GetCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedCallQuestionnaireRuleServiceServer) List(context.Context, *Empty) (*CallQuestionnaireRuleList, error) {
+func (UnimplementedCallQuestionnaireRuleServiceServer) List(context.Context, *ListCallQuestionnaireRulesRequest) (*CallQuestionnaireRuleList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
 func (UnimplementedCallQuestionnaireRuleServiceServer) Create(context.Context, *UpsertCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error) {
@@ -130,9 +158,15 @@ func (UnimplementedCallQuestionnaireRuleServiceServer) Create(context.Context, *
 func (UnimplementedCallQuestionnaireRuleServiceServer) Update(context.Context, *UpsertCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedCallQuestionnaireRuleServiceServer) Patch(context.Context, *PatchCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+}
 func (UnimplementedCallQuestionnaireRuleServiceServer) Delete(context.Context, *DeleteCallQuestionnaireRuleRequest) (*CallQuestionnaireRule, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedCallQuestionnaireRuleServiceServer) PreviewRule(context.Context, *PreviewCallQuestionnaireRuleRequest) (*PreviewCallQuestionnaireRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRule not implemented")
+}
 func (UnimplementedCallQuestionnaireRuleServiceServer) mustEmbedUnimplementedCallQuestionnaireRuleServiceServer() {
 }
 func (UnimplementedCallQuestionnaireRuleServiceServer) testEmbeddedByValue() {}
@@ -174,7 +208,7 @@ func _CallQuestionnaireRuleService_Get_Handler(srv interface{}, ctx context.Cont
 }
 
 func _CallQuestionnaireRuleService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ListCallQuestionnaireRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -186,7 +220,7 @@ func _CallQuestionnaireRuleService_List_Handler(srv interface{}, ctx context.Con
 		FullMethod: CallQuestionnaireRuleService_List_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CallQuestionnaireRuleServiceServer).List(ctx, req.(*Empty))
+		return srv.(CallQuestionnaireRuleServiceServer).List(ctx, req.(*ListCallQuestionnaireRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -227,6 +261,24 @@ func _CallQuestionnaireRuleService_Update_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallQuestionnaireRuleService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCallQuestionnaireRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallQuestionnaireRuleServiceServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallQuestionnaireRuleService_Patch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallQuestionnaireRuleServiceServer).Patch(ctx, req.(*PatchCallQuestionnaireRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CallQuestionnaireRuleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCallQuestionnaireRuleRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +297,24 @@ func _CallQuestionnaireRuleService_Delete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallQuestionnaireRuleService_PreviewRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCallQuestionnaireRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallQuestionnaireRuleServiceServer).PreviewRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallQuestionnaireRuleService_PreviewRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallQuestionnaireRuleServiceServer).PreviewRule(ctx, req.(*PreviewCallQuestionnaireRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CallQuestionnaireRuleService_ServiceDesc is the grpc.ServiceDesc for CallQuestionnaireRuleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,10 +338,18 @@ var CallQuestionnaireRuleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _CallQuestionnaireRuleService_Update_Handler,
 		},
+		{
+			MethodName: "Patch",
+			Handler:    _CallQuestionnaireRuleService_Patch_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _CallQuestionnaireRuleService_Delete_Handler,
 		},
+		{
+			MethodName: "PreviewRule",
+			Handler:    _CallQuestionnaireRuleService_PreviewRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "call_audit/call_questionnaire_rule.proto",
@@ -34,6 +34,8 @@ type LanguageProfile struct {
 	Name          string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
 	Token         string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
 	Type          int32                  `protobuf:"varint,9,opt,name=type,proto3" json:"type,omitempty"`
+	Ver           int32                  `protobuf:"varint,10,opt,name=ver,proto3" json:"ver,omitempty"`
+	Etag          string                 `protobuf:"bytes,11,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +133,20 @@ func (x *LanguageProfile) GetType() int32 {
 	return 0
 }
 
+func (x *LanguageProfile) GetVer() int32 {
+	if x != nil {
+		return x.Ver
+	}
+	return 0
+}
+
+func (x *LanguageProfile) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type GetLanguageProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -312,12 +328,14 @@ func (x *CreateLanguageProfileRequest) GetType() int32 {
 }
 
 type UpdateLanguageProfileRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UpdatedBy     int64                  `protobuf:"varint,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	Type          int32                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpdatedBy int64                  `protobuf:"varint,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Token     string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Type      int32                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	// optional, rejects the update when the profile has been changed since
+	Etag          string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -387,16 +405,109 @@ func (x *UpdateLanguageProfileRequest) GetType() int32 {
 	return 0
 }
 
+func (x *UpdateLanguageProfileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Only the fields listed in x_json_mask are changed, all fields when the mask is empty
+type PatchLanguageProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	XJsonMask     []string               `protobuf:"bytes,5,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	Etag          string                 `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchLanguageProfileRequest) Reset() {
+	*x = PatchLanguageProfileRequest{}
+	mi := &file_call_audit_language_profiles_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchLanguageProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchLanguageProfileRequest) ProtoMessage() {}
+
+func (x *PatchLanguageProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_language_profiles_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchLanguageProfileRequest.ProtoReflect.Descriptor instead.
+func (*PatchLanguageProfileRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_language_profiles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchLanguageProfileRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatchLanguageProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PatchLanguageProfileRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PatchLanguageProfileRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PatchLanguageProfileRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+func (x *PatchLanguageProfileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteLanguageProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteLanguageProfileRequest) Reset() {
 	*x = DeleteLanguageProfileRequest{}
-	mi := &file_call_audit_language_profiles_proto_msgTypes[5]
+	mi := &file_call_audit_language_profiles_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +519,7 @@ func (x *DeleteLanguageProfileRequest) String() string {
 func (*DeleteLanguageProfileRequest) ProtoMessage() {}
 
 func (x *DeleteLanguageProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_call_audit_language_profiles_proto_msgTypes[5]
+	mi := &file_call_audit_language_profiles_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +532,7 @@ func (x *DeleteLanguageProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLanguageProfileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLanguageProfileRequest) Descriptor() ([]byte, []int) {
-	return file_call_audit_language_profiles_proto_rawDescGZIP(), []int{5}
+	return file_call_audit_language_profiles_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteLanguageProfileRequest) GetId() int32 {
@@ -431,6 +542,13 @@ func (x *DeleteLanguageProfileRequest) GetId() int32 {
 	return 0
 }
 
+func (x *DeleteLanguageProfileRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type ListLanguageProfilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Profiles      []*LanguageProfile     `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
@@ -440,7 +558,7 @@ type ListLanguageProfilesResponse struct {
 
 func (x *ListLanguageProfilesResponse) Reset() {
 	*x = ListLanguageProfilesResponse{}
-	mi := &file_call_audit_language_profiles_proto_msgTypes[6]
+	mi := &file_call_audit_language_profiles_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +570,7 @@ func (x *ListLanguageProfilesResponse) String() string {
 func (*ListLanguageProfilesResponse) ProtoMessage() {}
 
 func (x *ListLanguageProfilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_call_audit_language_profiles_proto_msgTypes[6]
+	mi := &file_call_audit_language_profiles_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +583,7 @@ func (x *ListLanguageProfilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLanguageProfilesResponse.ProtoReflect.Descriptor instead.
 func (*ListLanguageProfilesResponse) Descriptor() ([]byte, []int) {
-	return file_call_audit_language_profiles_proto_rawDescGZIP(), []int{6}
+	return file_call_audit_language_profiles_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListLanguageProfilesResponse) GetProfiles() []*LanguageProfile {
@@ -480,7 +598,7 @@ var File_call_audit_language_profiles_proto protoreflect.FileDescriptor
 const file_call_audit_language_profiles_proto_rawDesc = "" +
 	"\n" +
 	"\"call_audit/language_profiles.proto\x12\n" +
-	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb0\x02\n" +
+	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18call_audit/options.proto\"\xd6\x02\n" +
 	"\x0fLanguageProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\x05R\bdomainId\x129\n" +
@@ -494,7 +612,10 @@ const file_call_audit_language_profiles_proto_rawDesc = "" +
 	"updated_by\x18\x06 \x01(\x03R\tupdatedBy\x12\x12\n" +
 	"\x04name\x18\a \x01(\tR\x04name\x12\x14\n" +
 	"\x05token\x18\b \x01(\tR\x05token\x12\x12\n" +
-	"\x04type\x18\t \x01(\x05R\x04type\"+\n" +
+	"\x04type\x18\t \x01(\x05R\x04type\x12\x10\n" +
+	"\x03ver\x18\n" +
+	" \x01(\x05R\x03ver\x12\x12\n" +
+	"\x04etag\x18\v \x01(\tR\x04etag\"+\n" +
 	"\x19GetLanguageProfileRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"h\n" +
 	"\x1bListLanguageProfilesRequest\x12\x1b\n" +
@@ -507,25 +628,35 @@ const file_call_audit_language_profiles_proto_rawDesc = "" +
 	"created_by\x18\x02 \x01(\x03R\tcreatedBy\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
 	"\x05token\x18\x04 \x01(\tR\x05token\x12\x12\n" +
-	"\x04type\x18\x05 \x01(\x05R\x04type\"\x8b\x01\n" +
+	"\x04type\x18\x05 \x01(\x05R\x04type\"\x9f\x01\n" +
 	"\x1cUpdateLanguageProfileRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
 	"updated_by\x18\x02 \x01(\x03R\tupdatedBy\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
 	"\x05token\x18\x04 \x01(\tR\x05token\x12\x12\n" +
-	"\x04type\x18\x05 \x01(\x05R\x04type\".\n" +
+	"\x04type\x18\x05 \x01(\x05R\x04type\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\"\x9f\x01\n" +
+	"\x1bPatchLanguageProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12\x1e\n" +
+	"\vx_json_mask\x18\x05 \x03(\tR\txJsonMask\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\"B\n" +
 	"\x1cDeleteLanguageProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"W\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"W\n" +
 	"\x1cListLanguageProfilesResponse\x127\n" +
-	"\bprofiles\x18\x01 \x03(\v2\x1b.call_audit.LanguageProfileR\bprofiles2\xb1\x03\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x1b.call_audit.LanguageProfileR\bprofiles2\x86\x04\n" +
 	"\x16LanguageProfileService\x12I\n" +
 	"\x03Get\x12%.call_audit.GetLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfile\x12Y\n" +
 	"\x04List\x12'.call_audit.ListLanguageProfilesRequest\x1a(.call_audit.ListLanguageProfilesResponse\x12O\n" +
 	"\x06Create\x12(.call_audit.CreateLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfile\x12O\n" +
-	"\x06Update\x12(.call_audit.UpdateLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfile\x12O\n" +
-	"\x06Delete\x12(.call_audit.DeleteLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfileB\x9f\x01\n" +
-	"\x0ecom.call_auditB\x15LanguageProfilesProtoP\x01Z2github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+	"\x06Update\x12(.call_audit.UpdateLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfile\x12S\n" +
+	"\x05Patch\x12'.call_audit.PatchLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfile\"\x04\x88\xb5\x18\x02\x12O\n" +
+	"\x06Delete\x12(.call_audit.DeleteLanguageProfileRequest\x1a\x1b.call_audit.LanguageProfileB\x99\x01\n" +
+	"\x0ecom.call_auditB\x15LanguageProfilesProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
 
 var (
 	file_call_audit_language_profiles_proto_rawDescOnce sync.Once
@@ -539,33 +670,36 @@ func file_call_audit_language_profiles_proto_rawDescGZIP() []byte {
 	return file_call_audit_language_profiles_proto_rawDescData
 }
 
-var file_call_audit_language_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_call_audit_language_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_call_audit_language_profiles_proto_goTypes = []any{
 	(*LanguageProfile)(nil),              // 0: call_audit.LanguageProfile
 	(*GetLanguageProfileRequest)(nil),    // 1: call_audit.GetLanguageProfileRequest
 	(*ListLanguageProfilesRequest)(nil),  // 2: call_audit.ListLanguageProfilesRequest
 	(*CreateLanguageProfileRequest)(nil), // 3: call_audit.CreateLanguageProfileRequest
 	(*UpdateLanguageProfileRequest)(nil), // 4: call_audit.UpdateLanguageProfileRequest
-	(*DeleteLanguageProfileRequest)(nil), // 5: call_audit.DeleteLanguageProfileRequest
-	(*ListLanguageProfilesResponse)(nil), // 6: call_audit.ListLanguageProfilesResponse
-	(*timestamppb.Timestamp)(nil),        // 7: google.protobuf.Timestamp
+	(*PatchLanguageProfileRequest)(nil),  // 5: call_audit.PatchLanguageProfileRequest
+	(*DeleteLanguageProfileRequest)(nil), // 6: call_audit.DeleteLanguageProfileRequest
+	(*ListLanguageProfilesResponse)(nil), // 7: call_audit.ListLanguageProfilesResponse
+	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
 }
 var file_call_audit_language_profiles_proto_depIdxs = []int32{
-	7, // 0: call_audit.LanguageProfile.created_at:type_name -> google.protobuf.Timestamp
-	7, // 1: call_audit.LanguageProfile.updated_at:type_name -> google.protobuf.Timestamp
+	8, // 0: call_audit.LanguageProfile.created_at:type_name -> google.protobuf.Timestamp
+	8, // 1: call_audit.LanguageProfile.updated_at:type_name -> google.protobuf.Timestamp
 	0, // 2: call_audit.ListLanguageProfilesResponse.profiles:type_name -> call_audit.LanguageProfile
 	1, // 3: call_audit.LanguageProfileService.Get:input_type -> call_audit.GetLanguageProfileRequest
 	2, // 4: call_audit.LanguageProfileService.List:input_type -> call_audit.ListLanguageProfilesRequest
 	3, // 5: call_audit.LanguageProfileService.Create:input_type -> call_audit.CreateLanguageProfileRequest
 	4, // 6: call_audit.LanguageProfileService.Update:input_type -> call_audit.UpdateLanguageProfileRequest
-	5, // 7: call_audit.LanguageProfileService.Delete:input_type -> call_audit.DeleteLanguageProfileRequest
-	0, // 8: call_audit.LanguageProfileService.Get:output_type -> call_audit.LanguageProfile
-	6, // 9: call_audit.LanguageProfileService.List:output_type -> call_audit.ListLanguageProfilesResponse
-	0, // 10: call_audit.LanguageProfileService.Create:output_type -> call_audit.LanguageProfile
-	0, // 11: call_audit.LanguageProfileService.Update:output_type -> call_audit.LanguageProfile
-	0, // 12: call_audit.LanguageProfileService.Delete:output_type -> call_audit.LanguageProfile
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
+	5, // 7: call_audit.LanguageProfileService.Patch:input_type -> call_audit.PatchLanguageProfileRequest
+	6, // 8: call_audit.LanguageProfileService.Delete:input_type -> call_audit.DeleteLanguageProfileRequest
+	0, // 9: call_audit.LanguageProfileService.Get:output_type -> call_audit.LanguageProfile
+	7, // 10: call_audit.LanguageProfileService.List:output_type -> call_audit.ListLanguageProfilesResponse
+	0, // 11: call_audit.LanguageProfileService.Create:output_type -> call_audit.LanguageProfile
+	0, // 12: call_audit.LanguageProfileService.Update:output_type -> call_audit.LanguageProfile
+	0, // 13: call_audit.LanguageProfileService.Patch:output_type -> call_audit.LanguageProfile
+	0, // 14: call_audit.LanguageProfileService.Delete:output_type -> call_audit.LanguageProfile
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -576,13 +710,14 @@ func file_call_audit_language_profiles_proto_init() {
 	if File_call_audit_language_profiles_proto != nil {
 		return
 	}
+	file_call_audit_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_audit_language_profiles_proto_rawDesc), len(file_call_audit_language_profiles_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,7 @@ const (
 	LanguageProfileService_List_FullMethodName   = "/call_audit.LanguageProfileService/List"
 	LanguageProfileService_Create_FullMethodName = "/call_audit.LanguageProfileService/Create"
 	LanguageProfileService_Update_FullMethodName = "/call_audit.LanguageProfileService/Update"
+	LanguageProfileService_Patch_FullMethodName  = "/call_audit.LanguageProfileService/Patch"
 	LanguageProfileService_Delete_FullMethodName = "/call_audit.LanguageProfileService/Delete"
 )
 
@@ -34,6 +35,7 @@ type LanguageProfileServiceClient interface {
 	List(ctx context.Context, in *ListLanguageProfilesRequest, opts ...grpc.CallOption) (*ListLanguageProfilesResponse, error)
 	Create(ctx context.Context, in *CreateLanguageProfileRequest, opts ...grpc.CallOption) (*LanguageProfile, error)
 	Update(ctx context.Context, in *UpdateLanguageProfileRequest, opts ...grpc.CallOption) (*LanguageProfile, error)
+	Patch(ctx context.Context, in *PatchLanguageProfileRequest, opts ...grpc.CallOption) (*LanguageProfile, error)
 	Delete(ctx context.Context, in *DeleteLanguageProfileRequest, opts ...grpc.CallOption) (*LanguageProfile, error)
 }
 
@@ -85,6 +87,16 @@ func (c *languageProfileServiceClient) Update(ctx context.Context, in *UpdateLan
 	return out, nil
 }
 
+func (c *languageProfileServiceClient) Patch(ctx context.Context, in *PatchLanguageProfileRequest, opts ...grpc.CallOption) (*LanguageProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LanguageProfile)
+	err := c.cc.Invoke(ctx, LanguageProfileService_Patch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *languageProfileServiceClient) Delete(ctx context.Context, in *DeleteLanguageProfileRequest, opts ...grpc.CallOption) (*LanguageProfile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LanguageProfile)
@@ -103,6 +115,7 @@ type LanguageProfileServiceServer interface {
 	List(context.Context, *ListLanguageProfilesRequest) (*ListLanguageProfilesResponse, error)
 	Create(context.Context, *CreateLanguageProfileRequest) (*LanguageProfile, error)
 	Update(context.Context, *UpdateLanguageProfileRequest) (*LanguageProfile, error)
+	Patch(context.Context, *PatchLanguageProfileRequest) (*LanguageProfile, error)
 	Delete(context.Context, *DeleteLanguageProfileRequest) (*LanguageProfile, error)
 	mustEmbedUnimplementedLanguageProfileServiceServer()
 }
@@ -126,6 +139,9 @@ func (UnimplementedLanguageProfileServiceServer) Create(context.Context, *Create
 func (UnimplementedLanguageProfileServiceServer) Update(context.Context, *UpdateLanguageProfileRequest) (*LanguageProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedLanguageProfileServiceServer) Patch(context.Context, *PatchLanguageProfileRequest) (*LanguageProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+}
 func (UnimplementedLanguageProfileServiceServer) Delete(context.Context, *DeleteLanguageProfileRequest) (*LanguageProfile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -223,6 +239,24 @@ func _LanguageProfileService_Update_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LanguageProfileService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchLanguageProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LanguageProfileServiceServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LanguageProfileService_Patch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LanguageProfileServiceServer).Patch(ctx, req.(*PatchLanguageProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LanguageProfileService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteLanguageProfileRequest)
 	if err := dec(in); err != nil {
@@ -264,6 +298,10 @@ var LanguageProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _LanguageProfileService_Update_Handler,
 		},
+		{
+			MethodName: "Patch",
+			Handler:    _LanguageProfileService_Patch_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _LanguageProfileService_Delete_Handler,
@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 
 	cerr "github.com/webitel/call_audit/internal/errors"
 )
@@ -13,9 +14,12 @@ type AppConfig struct {
 	File            string                `json:"-"`
 	Database        *DatabaseConfig       `json:"database,omitempty"`
 	Consul          *ConsulConfig         `json:"consul,omitempty"`
+	Rabbit          *RabbitConfig         `json:"rabbit,omitempty"`
 	TriggerWatcher  *TriggerWatcherConfig `json:"trigger_watcher,omitempty"`
+	CallEvents      *CallEventsConfig     `json:"call_events,omitempty"`
 	FtsWatcher      *FtsWatcherConfig     `json:"fts_watcher,omitempty"`
 	LoggerWatcher   *LoggerWatcherConfig  `json:"logger_watcher,omitempty"`
+	Jobs            *JobsConfig           `json:"jobs,omitempty"`
 	WatchersEnabled bool                  `json:"watchers_enabled,omitempty"`
 }
 
@@ -33,6 +37,29 @@ type TriggerWatcherConfig struct {
 	Enabled      bool   `json:"enabled" flag:"trigger_watch_enabled || watch_enabled"`
 }
 
+// CallEventsConfig is the source of the recording stored events the jobs are created from.
+// The storage service publishes a stored file to its trigger exchange with the routing key
+// "<exchange>.<channel>_files.create.<domain_id>", the recordings of the calls have the "call" channel.
+type CallEventsConfig struct {
+	Enabled    bool   `json:"enabled" flag:"call_events_enabled || consume call events"`
+	Exchange   string `json:"exchange" flag:"call_events_exchange || storage trigger exchange"`
+	RoutingKey string `json:"routing_key" flag:"call_events_routing_key || recording stored routing key"`
+	// ReconcileSec is the rule polling interval while events are consumed, the poller only catches missed calls then
+	ReconcileSec int `json:"reconcile_sec" flag:"call_events_reconcile_sec || reconcile interval"`
+	// LookbackSec is how far before the last_stored_at of a rule the poller looks for the calls missed by the events
+	LookbackSec int `json:"lookback_sec" flag:"call_events_lookback_sec || reconcile lookback"`
+}
+
+// JobsConfig sizes the job workers of the instance and shares them across the domains and the rules.
+type JobsConfig struct {
+	Workers   int `json:"workers" flag:"job_workers || audit workers"`
+	QueueSize int `json:"queue_size" flag:"job_queue_size || queued audits"`
+	// RuleMaxActive caps the unfinished polled jobs of a rule, no new calls are polled for the rule above it
+	RuleMaxActive int `json:"rule_max_active" flag:"job_rule_max_active || unfinished jobs per rule"`
+	// DomainMaxRunning caps the running jobs of a domain across the instances, 0 is no cap
+	DomainMaxRunning int `json:"domain_max_running" flag:"job_domain_max_running || running jobs per domain"`
+}
+
 type FtsWatcherConfig struct {
 	Enabled bool `json:"enabled" flag:"fts_watch_enabled || watch_enabled"`
 }
@@ -62,6 +89,19 @@ func LoadConfig() (*AppConfig, error) { // Change to return standard error
 	flag.StringVar(&triggerConfig.TopicName, "trigger_watcher_topic", "", "Queue name")
 	flag.BoolVar(&triggerConfig.Enabled, "trigger_watch_enabled", true, "Watcher enabled")
 
+	callEventsConfig := new(CallEventsConfig)
+	flag.BoolVar(&callEventsConfig.Enabled, "call_events_enabled", true, "Create the jobs from the recording stored events")
+	flag.StringVar(&callEventsConfig.Exchange, "call_events_exchange", "", "Exchange of the recording stored events")
+	flag.StringVar(&callEventsConfig.RoutingKey, "call_events_routing_key", "", "Routing key of the recording stored events")
+	flag.IntVar(&callEventsConfig.ReconcileSec, "call_events_reconcile_sec", 0, "Rule polling interval in seconds while events are consumed")
+	flag.IntVar(&callEventsConfig.LookbackSec, "call_events_lookback_sec", 0, "Seconds before the last stored call of a rule the poller looks for missed calls")
+
+	jobsConfig := new(JobsConfig)
+	flag.IntVar(&jobsConfig.Workers, "job_workers", 0, "Audit workers of the instance")
+	flag.IntVar(&jobsConfig.QueueSize, "job_queue_size", 0, "Audits queued for the workers")
+	flag.IntVar(&jobsConfig.RuleMaxActive, "job_rule_max_active", 0, "Unfinished jobs per rule")
+	flag.IntVar(&jobsConfig.DomainMaxRunning, "job_domain_max_running", -1, "Running jobs per domain across the instances, 0 is no cap")
+
 	loggerConfig := new(LoggerWatcherConfig)
 	flag.BoolVar(&loggerConfig.Enabled, "logger_watch_enabled", true, "Watcher enabled")
 
@@ -108,10 +148,78 @@ func LoadConfig() (*AppConfig, error) { // Change to return standard error
 		triggerConfig.TopicName = value
 	}
 
+	if callEventsConfig.Exchange == "" {
+		value := "cases"
+		if env := os.Getenv("CALL_EVENTS_EXCHANGE"); env != "" {
+			value = env
+		}
+		callEventsConfig.Exchange = value
+	}
+
+	if callEventsConfig.RoutingKey == "" {
+		value := "*.call_files.create.*"
+		if env := os.Getenv("CALL_EVENTS_ROUTING_KEY"); env != "" {
+			value = env
+		}
+		callEventsConfig.RoutingKey = value
+	}
+
+	if callEventsConfig.ReconcileSec <= 0 {
+		value := 300
+		if env, err := strconv.Atoi(os.Getenv("CALL_EVENTS_RECONCILE_SEC")); err == nil && env > 0 {
+			value = env
+		}
+		callEventsConfig.ReconcileSec = value
+	}
+
+	if callEventsConfig.LookbackSec <= 0 {
+		value := 3600
+		if env, err := strconv.Atoi(os.Getenv("CALL_EVENTS_LOOKBACK_SEC")); err == nil && env > 0 {
+			value = env
+		}
+		callEventsConfig.LookbackSec = value
+	}
+
+	if jobsConfig.Workers <= 0 {
+		value := 100
+		if env, err := strconv.Atoi(os.Getenv("JOB_WORKERS")); err == nil && env > 0 {
+			value = env
+		}
+		jobsConfig.Workers = value
+	}
+
+	if jobsConfig.QueueSize <= 0 {
+		value := 10
+		if env, err := strconv.Atoi(os.Getenv("JOB_QUEUE_SIZE")); err == nil && env > 0 {
+			value = env
+		}
+		jobsConfig.QueueSize = value
+	}
+
+	if jobsConfig.RuleMaxActive <= 0 {
+		value := 100
+		if env, err := strconv.Atoi(os.Getenv("JOB_RULE_MAX_ACTIVE")); err == nil && env > 0 {
+			value = env
+		}
+		jobsConfig.RuleMaxActive = value
+	}
+
+	if jobsConfig.DomainMaxRunning < 0 {
+		value := 0
+		if env, err := strconv.Atoi(os.Getenv("JOB_DOMAIN_MAX_RUNNING")); err == nil && env >= 0 {
+			value = env
+		}
+		jobsConfig.DomainMaxRunning = value
+	}
+
 	if env := os.Getenv("TRIGGER_WATCHER_ENABLED"); env != "" {
 		triggerConfig.Enabled = env == "1" || env == "true"
 	}
 
+	if env := os.Getenv("CALL_EVENTS_ENABLED"); env != "" {
+		callEventsConfig.Enabled = env == "1" || env == "true"
+	}
+
 	if env := os.Getenv("LOGGER_WATCHER_ENABLED"); env != "" {
 		loggerConfig.Enabled = env == "1" || env == "true"
 	}
@@ -128,13 +236,18 @@ func LoadConfig() (*AppConfig, error) { // Change to return standard error
 	appConfig.Database = &DatabaseConfig{
 		Url: *dataSource,
 	}
+	appConfig.Rabbit = &RabbitConfig{
+		Url: *rabbitURL,
+	}
 	appConfig.Consul = &ConsulConfig{
 		Id:            *consulID,
 		Address:       *consul,
 		PublicAddress: *grpcAddr,
 	}
 	appConfig.TriggerWatcher = triggerConfig
+	appConfig.CallEvents = callEventsConfig
 	appConfig.LoggerWatcher = loggerConfig
+	appConfig.Jobs = jobsConfig
 	appConfig.FtsWatcher = ftsConfig
 
 	// trying to load config from file
@@ -11,6 +11,9 @@ require (
 	github.com/jackc/pgx/v5 v5.7.5
 	github.com/jmoiron/sqlx v1.4.0
 	github.com/pkg/errors v0.9.1
+	github.com/rabbitmq/amqp091-go v1.10.0
+	github.com/robfig/cron v1.2.0
+	github.com/robfig/cron v1.2.0
 	github.com/webitel/storage v0.0.0-20250721055202-b28f9f19ed2a
 	go.opentelemetry.io/otel/sdk v1.36.0
 	golang.org/x/sync v0.15.0
@@ -45,7 +48,6 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/pborman/uuid v1.2.1 // indirect
 	github.com/pelletier/go-toml v1.9.5 // indirect
-	github.com/robfig/cron v1.2.0 // indirect
 	github.com/tidwall/gjson v1.14.4 // indirect
 	github.com/tidwall/match v1.1.1 // indirect
 	github.com/tidwall/pretty v1.2.1 // indirect
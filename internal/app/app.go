@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
 
 	"github.com/webitel/call_audit/auth"
 	"github.com/webitel/call_audit/auth/manager/webitel_app"
 	"github.com/webitel/call_audit/internal/errors"
 
 	conf "github.com/webitel/call_audit/config"
+	"github.com/webitel/call_audit/internal/broker"
 	cerror "github.com/webitel/call_audit/internal/errors"
 	"github.com/webitel/call_audit/internal/server"
 	"github.com/webitel/call_audit/internal/store"
@@ -39,11 +41,16 @@ type App struct {
 	log            *slog.Logger
 	rabbitExitChan chan cerror.AppError
 	engineConn     *grpc.ClientConn
+	callEvents     *broker.Consumer
+	auditEvents    *broker.Publisher
+	rules          ruleCache
+	// instanceID identifies this replica as the holder of job leases
+	instanceID string
 }
 
 func New(config *conf.AppConfig) (*App, error) {
 	// --------- App Initialization ---------
-	app := &App{config: config}
+	app := &App{config: config, instanceID: newInstanceID(config)}
 	var err error
 
 	// --------- DB Initialization ---------
@@ -90,6 +97,12 @@ func New(config *conf.AppConfig) (*App, error) {
 	return app, nil
 }
 
+// newInstanceID is unique per running process: the service id, host and pid.
+func newInstanceID(config *conf.AppConfig) string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%s-%d", config.Consul.Id, host, os.Getpid())
+}
+
 func BuildDatabase(config *conf.DatabaseConfig) store.Store {
 	return postgres.New(config)
 }
@@ -100,6 +113,18 @@ func (a *App) Start() error { // Change return type to standard error
 		return err
 	}
 
+	// Call events create jobs as soon as the calls are stored
+	a.callEvents = newCallEventsConsumer(a)
+	if a.callEvents != nil {
+		a.callEvents.Start()
+	}
+
+	// Audit outcomes are published to the trigger watcher exchange
+	a.auditEvents = newAuditEventsPublisher(a)
+	if a.auditEvents != nil {
+		a.auditEvents.Start()
+	}
+
 	// Jobs execution
 	StartJobs(a)
 
@@ -111,6 +136,12 @@ func (a *App) Start() error { // Change return type to standard error
 func (a *App) Stop() error { // Change return type to standard error
 	// close massive modules
 	a.server.Stop()
+	if a.callEvents != nil {
+		a.callEvents.Stop()
+	}
+	if a.auditEvents != nil {
+		a.auditEvents.Stop()
+	}
 	// close store connection
 	a.Store.Close()
 	// close grpc connections
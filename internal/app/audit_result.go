@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/webitel/call_audit/api/call_audit"
+	cerror "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
+	grpcopts "github.com/webitel/call_audit/model/options/grpc"
+)
+
+var AuditResultMetadata = model.NewObjectMetadata("", "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "domain_id", Default: false},
+	{Name: "created_at", Default: true},
+	{Name: "job_id", Default: false},
+	{Name: "rule", Default: true},
+	{Name: "call_id", Default: true},
+	{Name: "scorecard_id", Default: true},
+	{Name: "rate_id", Default: false},
+	{Name: "provider", Default: false},
+	{Name: "model", Default: true},
+	{Name: "prompt_version", Default: false},
+	{Name: "raw_response", Default: false},
+	{Name: "scores", Default: true},
+	{Name: "score", Default: true},
+	{Name: "summary", Default: true},
+	{Name: "category", Default: true},
+	{Name: "usage", Default: false},
+	{Name: "latency_ms", Default: false},
+})
+
+type AuditResultService struct {
+	app *App
+	pb.UnimplementedAuditResultServiceServer
+}
+
+func NewAuditResultService(app *App) (*AuditResultService, error) {
+	return &AuditResultService{app: app}, nil
+}
+
+func (s *AuditResultService) Search(ctx context.Context, req *pb.SearchAuditResultsRequest) (*pb.AuditResultList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithSearch(req),
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, AuditResultMetadata),
+		grpcopts.WithSort(req),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.audit_result.search.invalid_args", err.Error())
+	}
+
+	if v := req.GetCallId(); v != "" {
+		searchOpts.AddFilter("call_id", v)
+	}
+	if v := req.GetRuleId(); len(v) > 0 {
+		searchOpts.AddFilter("rule_id", v)
+	}
+	if v := req.GetCreatedFrom(); v != nil {
+		searchOpts.AddFilter("created_from", v.AsTime())
+	}
+	if v := req.GetCreatedTo(); v != nil {
+		searchOpts.AddFilter("created_to", v.AsTime())
+	}
+	if v := req.GetCategory(); len(v) > 0 {
+		searchOpts.AddFilter("category", v)
+	}
+	if v := req.GetScoreFrom(); v != nil {
+		searchOpts.AddFilter("score_from", v.GetValue())
+	}
+	if v := req.GetScoreTo(); v != nil {
+		searchOpts.AddFilter("score_to", v.GetValue())
+	}
+
+	items, err := s.app.Store.AuditResults().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search audit results: %w", err)
+	}
+	items, next := util.ResolvePaging(searchOpts.GetSize(), items)
+
+	return &pb.AuditResultList{
+		Items: items,
+		Page:  int32(searchOpts.GetPage()),
+		Next:  next,
+	}, nil
+}
+
+func (s *AuditResultService) Get(ctx context.Context, req *pb.GetAuditResultRequest) (*pb.AuditResult, error) {
+	searchOpts, err := grpcopts.NewLocateOptions(
+		ctx,
+		grpcopts.WithID(req.GetId()),
+		grpcopts.WithFields(req, AuditResultMetadata),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.audit_result.get.invalid_args", err.Error())
+	}
+
+	items, err := s.app.Store.AuditResults().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit result: %w", err)
+	}
+	if len(items) == 0 {
+		return nil, cerror.NewNotFoundError("app.audit_result.get.not_found", fmt.Sprintf("audit result %d not found", req.GetId()))
+	}
+	return items[0], nil
+}
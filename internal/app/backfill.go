@@ -0,0 +1,198 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/webitel/call_audit/api/call_audit"
+	cerror "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
+	"github.com/webitel/call_audit/model/options"
+	grpcopts "github.com/webitel/call_audit/model/options/grpc"
+	"github.com/webitel/call_audit/model/options/grpc/shared"
+)
+
+var BackfillMetadata = model.NewObjectMetadata("", "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "rule", Default: true},
+	{Name: "from", Default: true},
+	{Name: "to", Default: true},
+	{Name: "state", Default: true},
+	{Name: "batch_size", Default: false},
+	{Name: "total", Default: true},
+	{Name: "queued", Default: true},
+	{Name: "done", Default: true},
+	{Name: "failed", Default: true},
+	{Name: "pending", Default: true},
+	{Name: "created_at", Default: true},
+	{Name: "created_by", Default: false},
+	{Name: "updated_at", Default: false},
+})
+
+type BackfillService struct {
+	app *App
+	pb.UnimplementedBackfillServiceServer
+}
+
+func NewBackfillService(app *App) (*BackfillService, error) {
+	return &BackfillService{app: app}, nil
+}
+
+// Create starts auditing the calls of the rule stored in the window, the total is counted once here.
+func (s *BackfillService) Create(ctx context.Context, req *pb.CreateBackfillRequest) (*pb.Backfill, error) {
+	batchSize := req.GetBatchSize()
+	if batchSize == 0 {
+		batchSize = model.BackfillDefaultBatchSize
+	}
+	switch {
+	case req.GetRuleId() == 0:
+		return nil, cerror.NewBadRequestError("app.backfill.create.rule_id_required", "rule_id is required")
+	case req.GetFrom() == nil || req.GetTo() == nil:
+		return nil, cerror.NewBadRequestError("app.backfill.create.window_required", "from and to are required")
+	case !req.GetFrom().AsTime().Before(req.GetTo().AsTime()):
+		return nil, cerror.NewBadRequestError("app.backfill.create.invalid_window", "from must be before to")
+	case req.GetTo().AsTime().After(time.Now()):
+		return nil, cerror.NewBadRequestError("app.backfill.create.invalid_window", "to must not be in the future")
+	case batchSize < 0 || batchSize > model.BackfillMaxBatchSize:
+		return nil, cerror.NewBadRequestError("app.backfill.create.invalid_batch_size", fmt.Sprintf("batch_size must be between 1 and %d", model.BackfillMaxBatchSize))
+	}
+
+	createOpts, err := grpcopts.NewCreateOptions(ctx, grpcopts.WithCreateFields(req, BackfillMetadata))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.backfill.create.invalid_args", err.Error())
+	}
+	rule, err := getRule(s.app, createOpts.GetAuthOpts().GetDomainId(), req.GetRuleId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule: %w", err)
+	}
+	if rule == nil {
+		return nil, cerror.NewNotFoundError("app.backfill.create.rule_not_found", fmt.Sprintf("call questionnaire rule %d not found", req.GetRuleId()))
+	}
+	if rule.PausedReason != "" {
+		return nil, cerror.NewConflictError("app.backfill.create.rule_paused", fmt.Sprintf("call questionnaire rule %d is paused: %s", req.GetRuleId(), rule.PausedReason))
+	}
+	total, err := countBackfillCalls(s.app, rule, req.GetFrom().AsTime(), req.GetTo().AsTime())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count backfill calls: %w", err)
+	}
+
+	backfill, err := s.app.Store.Backfills().Create(createOpts, &pb.Backfill{
+		Rule:      &pb.Lookup{Id: req.GetRuleId()},
+		From:      req.GetFrom(),
+		To:        req.GetTo(),
+		BatchSize: batchSize,
+		Total:     total,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backfill: %w", err)
+	}
+	return backfill, nil
+}
+
+func (s *BackfillService) Search(ctx context.Context, req *pb.SearchBackfillsRequest) (*pb.BackfillList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, BackfillMetadata),
+		grpcopts.WithSort(req),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.backfill.search.invalid_args", err.Error())
+	}
+	if v := req.GetRuleId(); len(v) > 0 {
+		searchOpts.AddFilter("rule_id", v)
+	}
+	if v := req.GetState(); len(v) > 0 {
+		states := make([]model.BackfillState, 0, len(v))
+		for _, state := range v {
+			states = append(states, model.BackfillState(state))
+		}
+		searchOpts.AddFilter("state", states)
+	}
+
+	items, err := s.app.Store.Backfills().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search backfills: %w", err)
+	}
+	items, next := util.ResolvePaging(searchOpts.GetSize(), items)
+
+	return &pb.BackfillList{
+		Items: items,
+		Page:  int32(searchOpts.GetPage()),
+		Next:  next,
+	}, nil
+}
+
+func (s *BackfillService) Get(ctx context.Context, req *pb.GetBackfillRequest) (*pb.Backfill, error) {
+	searchOpts, err := grpcopts.NewLocateOptions(
+		ctx,
+		grpcopts.WithID(req.GetId()),
+		grpcopts.WithFields(req, BackfillMetadata),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.backfill.get.invalid_args", err.Error())
+	}
+
+	items, err := s.app.Store.Backfills().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backfill: %w", err)
+	}
+	if len(items) == 0 {
+		return nil, cerror.NewNotFoundError("app.backfill.get.not_found", fmt.Sprintf("backfill %d not found", req.GetId()))
+	}
+	return items[0], nil
+}
+
+// Pause stops queuing new batches of a running backfill.
+func (s *BackfillService) Pause(ctx context.Context, req *pb.PauseBackfillRequest) (*pb.Backfill, error) {
+	return s.setState(ctx, "pause", req.GetId(), req, s.app.Store.Backfills().Pause)
+}
+
+// Resume continues a paused backfill from where it stopped.
+func (s *BackfillService) Resume(ctx context.Context, req *pb.ResumeBackfillRequest) (*pb.Backfill, error) {
+	return s.setState(ctx, "resume", req.GetId(), req, s.app.Store.Backfills().Resume)
+}
+
+// Cancel stops a running or paused backfill for good.
+func (s *BackfillService) Cancel(ctx context.Context, req *pb.CancelBackfillRequest) (*pb.Backfill, error) {
+	return s.setState(ctx, "cancel", req.GetId(), req, s.app.Store.Backfills().Cancel)
+}
+
+func (s *BackfillService) setState(
+	ctx context.Context,
+	action string,
+	id int64,
+	req shared.Fielder,
+	set func(rpc options.UpdateOptions) (*pb.Backfill, error),
+) (*pb.Backfill, error) {
+	updateOpts, err := grpcopts.NewUpdateOptions(
+		ctx,
+		grpcopts.WithUpdateFields(req, BackfillMetadata),
+		grpcopts.WithUpdateIDs([]int64{id}),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.backfill."+action+".invalid_args", err.Error())
+	}
+
+	backfill, err := set(updateOpts)
+	if err != nil {
+		return nil, backfillStoreError(action, id, err)
+	}
+	return backfill, nil
+}
+
+func backfillStoreError(action string, id int64, err error) error {
+	var noRows *cerror.DBNoRowsError
+	if errors.As(err, &noRows) {
+		return cerror.NewNotFoundError("app.backfill."+action+".not_found", fmt.Sprintf("backfill %d not found", id))
+	}
+	var conflict *cerror.DBConflictError
+	if errors.As(err, &conflict) {
+		return cerror.NewConflictError("app.backfill."+action+".conflict", fmt.Sprintf("backfill %d state does not allow to %s it", id, action))
+	}
+	return fmt.Errorf("failed to %s backfill: %w", action, err)
+}
@@ -0,0 +1,134 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/webitel/call_audit/internal/broker"
+	"github.com/webitel/call_audit/model"
+)
+
+const (
+	callEventsQueue       = "call_audit.recording_stored"
+	auditEventsBufferSize = 10000
+	// recordingChannel is the upload channel of the call recordings in the storage service
+	recordingChannel = "call"
+)
+
+// recordingStoredEvent is the file stored event of the storage service trigger watcher, the
+// FileAMQPMessage of github.com/webitel/storage/model with the fields read here:
+//
+//	{"file": {"id": 1, "domain_id": 1, "uuid": "<call id>", "channel": "call", "created_at": <unix ms>}}
+//
+// A recording is stored under the id of its call, the other conditions of the rules
+// are checked against the call history when the job is created.
+type recordingStoredEvent struct {
+	File *struct {
+		ID       int64   `json:"id"`
+		DomainID int64   `json:"domain_id"`
+		UUID     string  `json:"uuid"`
+		Channel  *string `json:"channel"`
+		// unix time in milliseconds
+		CreatedAt int64 `json:"created_at"`
+	} `json:"file"`
+}
+
+// ruleCache holds the enabled rules that still accept jobs, refreshed by the rule poller.
+type ruleCache struct {
+	mu    sync.RWMutex
+	rules []model.CallQuestionnaireRule
+}
+
+func (c *ruleCache) set(rules []model.CallQuestionnaireRule) {
+	c.mu.Lock()
+	c.rules = rules
+	c.mu.Unlock()
+}
+
+// match returns the rules of the domain that may audit a recording stored at storedAt (unix ms, 0 when unknown),
+// the job query checks the call of the recording against the rules.
+func (c *ruleCache) match(domainID int64, storedAt int64) []model.CallQuestionnaireRule {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	stored := time.UnixMilli(storedAt)
+	var matched []model.CallQuestionnaireRule
+	for _, rule := range c.rules {
+		switch {
+		case int64(rule.DomainId) != domainID:
+		// a scheduled rule audits its calls on the next run only
+		case rule.Schedule != "":
+		case storedAt > 0 && stored.Before(rule.From):
+		case storedAt > 0 && rule.To != nil && stored.After(*rule.To):
+		default:
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// newCallEventsConsumer subscribes to the recording stored events of the storage service,
+// nil when the broker or the call events are not configured. The source exchange is not the one
+// the audit outcomes are published to, so the service never consumes its own events.
+func newCallEventsConsumer(app *App) *broker.Consumer {
+	cfg := app.config
+	if cfg.Rabbit == nil || cfg.Rabbit.Url == "" || !cfg.WatchersEnabled || cfg.CallEvents == nil || !cfg.CallEvents.Enabled {
+		slog.Info("call events consumer disabled, calls are found by polling only")
+		return nil
+	}
+	if cfg.TriggerWatcher != nil && cfg.CallEvents.Exchange == cfg.TriggerWatcher.ExchangeName {
+		slog.Error("call events exchange is the audit events exchange, calls are found by polling only",
+			slog.String("exchange", cfg.CallEvents.Exchange))
+		return nil
+	}
+	return broker.NewConsumer(
+		cfg.Rabbit.Url,
+		cfg.CallEvents.Exchange,
+		callEventsQueue,
+		[]string{cfg.CallEvents.RoutingKey},
+		func(ctx context.Context, routingKey string, body []byte) error {
+			return handleRecordingStored(app, body)
+		},
+	)
+}
+
+// newAuditEventsPublisher publishes the audit outcomes to the trigger watcher exchange,
+// nil when the broker is not configured.
+func newAuditEventsPublisher(app *App) *broker.Publisher {
+	cfg := app.config
+	if cfg.Rabbit == nil || cfg.Rabbit.Url == "" || cfg.TriggerWatcher == nil {
+		return nil
+	}
+	return broker.NewPublisher(cfg.Rabbit.Url, cfg.TriggerWatcher.ExchangeName, auditEventsBufferSize)
+}
+
+// handleRecordingStored enqueues a job of the call of a stored recording for every matching rule,
+// the files of the other channels are skipped. A call not in the history yet is left to the poller.
+// A failed job creation requeues the event, a malformed event is dropped.
+func handleRecordingStored(app *App, body []byte) error {
+	var ev recordingStoredEvent
+	if err := json.Unmarshal(body, &ev); err != nil {
+		return broker.Permanent(fmt.Errorf("invalid recording stored event: %w", err))
+	}
+	file := ev.File
+	if file == nil || file.Channel == nil || *file.Channel != recordingChannel {
+		return nil
+	}
+	if file.UUID == "" || file.DomainID == 0 {
+		return broker.Permanent(fmt.Errorf("recording stored event without uuid or domain_id"))
+	}
+
+	for _, rule := range app.rules.match(file.DomainID, file.CreatedAt) {
+		if err := createJobs(app, rule, file.UUID); err != nil {
+			return err
+		}
+		slog.Debug("Created job from recording event",
+			slog.Int("rule_id", rule.Id),
+			slog.String("call_id", file.UUID))
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/model"
+)
+
+// chunks splits a dialogue longer than the chunk size of the rule into overlapping chunks.
+// A shorter dialogue or a rule without the map-reduce strategy is a single chunk.
+func (r *Runner) chunks(job *model.CallJob, phrases []transcriptPhrase) [][]transcriptPhrase {
+	chunking := deref(job.Params.Chunking)
+	if chunking.Strategy == model.ChunkStrategyNone || len(phrases) == 0 {
+		return [][]transcriptPhrase{phrases}
+	}
+	maxTokens := int64(chunking.MaxTokens)
+	if maxTokens <= 0 {
+		maxTokens = model.DefaultChunkTokens
+	}
+	overlap := model.DefaultChunkOverlapPhrases
+	if chunking.OverlapPhrases != nil {
+		overlap = *chunking.OverlapPhrases
+	}
+	if llm.TextTokens(buildDialogue(phrases)) <= maxTokens {
+		return [][]transcriptPhrase{phrases}
+	}
+	return chunkPhrases(phrases, maxTokens, overlap)
+}
+
+// mapChunks sends the prompt of every chunk and joins the answers into notes numbered by chunk,
+// the notes take the place of the dialogue in the reducing request.
+func (r *Runner) mapChunks(ctx context.Context, provider llm.Provider, system string, chunks [][]transcriptPhrase, prompt func(part int, dialogue string) string, job *model.CallJob, res *AuditResult) (string, error) {
+	var notes strings.Builder
+	for i, chunk := range chunks {
+		content, err := func() (string, error) {
+			ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+			defer cancel()
+			chat, err := r.chat(ctx, provider, r.chatRequest(job,
+				llm.System(system),
+				llm.User(prompt(i+1, buildDialogue(chunk))),
+			), job, res)
+			if err != nil {
+				return "", err
+			}
+			return chat.Content, nil
+		}()
+		if err != nil {
+			slog.Error("LLM request failed (chunk)", slog.String("uuid", job.Params.CallID), slog.Int("chunk", i+1), slog.String("error", err.Error()))
+			return "", fmt.Errorf("llm request of chunk %d of %d failed: %w", i+1, len(chunks), err)
+		}
+		notes.WriteString(fmt.Sprintf("Частина %d з %d:\n%s\n\n", i+1, len(chunks), strings.TrimSpace(content)))
+	}
+	return notes.String(), nil
+}
+
+// buildSummaryChunkPrompt asks for the content of a part of the call, the parts are summarized together later.
+func buildSummaryChunkPrompt(part, parts int, dialogue string) string {
+	return fmt.Sprintf("Це частина %d з %d розмови, фрази на межах частин повторюються.\n"+
+		"Стисло перекажи її зміст: хто і чого хотів, про що домовились, які проблеми залишились.\n\n%s",
+		part, parts, dialogue)
+}
+
+// buildScorecardChunkPrompt asks for the facts of a part of the call relevant to the form,
+// the questions are answered from the facts of all the parts later.
+func buildScorecardChunkPrompt(part, parts int, dialogue string, form *model.ScorecardForm) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Це частина %d з %d дзвінка, фрази на межах частин повторюються.", part, parts))
+	b.WriteString(" Випиши з неї все, що стосується питань анкети: для кожного такого питання коротко, що зроблено або не зроблено," +
+		" з дослівними цитатами і номером фрази в квадратних дужках. Питання, про які в цій частині нічого немає, пропусти.\n\n")
+
+	b.WriteString("Частина дзвінка:\n")
+	b.WriteString(dialogue)
+	b.WriteString("\n\nАнкета:\n")
+	writeScorecardQuestions(&b, form)
+	return b.String()
+}
@@ -1,9 +1,13 @@
 package processor
 
 import (
+	"encoding/json"
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
 )
 
 type Config struct {
@@ -19,6 +23,16 @@ type Config struct {
 	DelayBetweenRetries     float64
 	MaxRetries              int
 	OpenAITemperature       float64
+	// ScorecardRepairAttempts bounds how many times invalid scorecard answers are sent back to the model.
+	ScorecardRepairAttempts int
+	// JobLeaseSec is how long a claimed job belongs to this instance without a renewal.
+	JobLeaseSec int
+	// JobMaxAttempts is how many times a job with a retryable failure runs before it is dead.
+	JobMaxAttempts int
+	// Prices of the models per million tokens, the cost of a model without a price is 0.
+	Prices llm.Prices
+	// Limits of the LLM requests per API key of this instance.
+	Limits llm.Limits
 }
 
 func LoadConfig() *Config {
@@ -50,7 +64,28 @@ func LoadConfig() *Config {
 		DelayBetweenRetries:     parseFloat("DELAY_BETWEEN_RETRIES", 10.0),
 		MaxRetries:              parseInt("MAX_RETRIES", 5),
 		OpenAITemperature:       parseFloat("OPENAI_TEMPERATURE", 0.3),
+		ScorecardRepairAttempts: parseInt("SCORECARD_REPAIR_ATTEMPTS", 2),
+		JobLeaseSec:             parseInt("JOB_LEASE_SEC", 60),
+		JobMaxAttempts:          parseInt("JOB_MAX_ATTEMPTS", 5),
+		Prices:                  parsePrices(os.Getenv("LLM_PRICES")),
+		Limits: llm.Limits{
+			RequestsPerMin: parseInt("LLM_REQUESTS_PER_MIN", 0),
+			TokensPerMin:   parseInt("LLM_TOKENS_PER_MIN", 0),
+		},
+	}
+}
+
+// parsePrices reads the price table in JSON, e.g. {"gpt-4o": {"prompt": 2.5, "completion": 10}}.
+func parsePrices(raw string) llm.Prices {
+	if raw == "" {
+		return nil
+	}
+	var prices llm.Prices
+	if err := json.Unmarshal([]byte(raw), &prices); err != nil {
+		slog.Error("LLM_PRICES is not a price table", slog.String("error", err.Error()))
+		return nil
 	}
+	return prices
 }
 
 func split(raw string) []string {
@@ -0,0 +1,78 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/webitel/call_audit/model"
+)
+
+// Audit outcome statuses, also the last part of the routing key.
+const (
+	AuditStatusCompleted = "completed"
+	AuditStatusFailed    = "failed"
+)
+
+// EventPublisher delivers the audit outcome events.
+type EventPublisher interface {
+	Publish(routingKey string, body []byte)
+}
+
+// AuditEvent is published once a job is completed or failed.
+type AuditEvent struct {
+	JobID       int64  `json:"job_id"`
+	CallID      string `json:"call_id"`
+	RuleID      int64  `json:"rule_id"`
+	DomainID    int64  `json:"domain_id"`
+	Status      string `json:"status"`
+	ScorecardID int    `json:"scorecard_id,omitempty"`
+	RateID      int64  `json:"rate_id,omitempty"`
+	// answers in the order of the scorecard questions, null for a skipped question
+	Scores   []*int `json:"scores,omitempty"`
+	Score    *int   `json:"score,omitempty"`
+	Summary  string `json:"summary,omitempty"`
+	Category string `json:"category,omitempty"`
+	Error    string `json:"error,omitempty"`
+	// unix time in milliseconds
+	CreatedAt int64 `json:"created_at"`
+}
+
+// AuditRoutingKey is audit.<domain_id>.<status>, so consumers can bind to a single domain or status.
+func AuditRoutingKey(domainID int64, status string) string {
+	return fmt.Sprintf("audit.%d.%s", domainID, status)
+}
+
+// publishOutcome publishes the result of the job, or the failure reason when err is set.
+func (r *Runner) publishOutcome(job *model.CallJob, res *AuditResult, err error) {
+	if r.events == nil {
+		return
+	}
+
+	ev := AuditEvent{
+		JobID:       job.ID,
+		CallID:      job.Params.CallID,
+		RuleID:      job.RuleID,
+		DomainID:    job.Params.DomainID,
+		Status:      AuditStatusCompleted,
+		ScorecardID: job.Params.Scorecard,
+		RateID:      res.RateID,
+		Scores:      res.Scores,
+		Score:       totalScore(res.Scores),
+		Summary:     res.Summary,
+		Category:    res.Category,
+		CreatedAt:   time.Now().UnixMilli(),
+	}
+	if err != nil {
+		ev.Status = AuditStatusFailed
+		ev.Error = err.Error()
+	}
+
+	body, marshalErr := json.Marshal(ev)
+	if marshalErr != nil {
+		slog.Error("Failed to encode audit event", slog.String("uuid", job.Params.CallID), slog.String("error", marshalErr.Error()))
+		return
+	}
+	r.events.Publish(AuditRoutingKey(ev.DomainID, ev.Status), body)
+}
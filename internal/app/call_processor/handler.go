@@ -1,53 +1,53 @@
 package processor
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 
+	"github.com/webitel/call_audit/internal/store"
 	"github.com/webitel/call_audit/model"
 )
 
+// ErrAlreadyProcessing is returned when another job of the same rule is processing the call.
+var ErrAlreadyProcessing = errors.New("call is already being processed")
+
 type App struct {
 	Cfg    *Config
 	State  *UUIDState
 	Runner *Runner
 }
 
-func NewApp(cfg *Config) *App {
+// NewApp creates the job processor, events may be nil when outcomes are not published.
+func NewApp(cfg *Config, serviceStore store.ServiceStore, events EventPublisher) *App {
 	return &App{
 		Cfg:    cfg,
 		State:  NewUUIDState(),
-		Runner: NewRunner(cfg),
+		Runner: NewRunner(cfg, serviceStore, events),
 	}
 }
 
-func (a *App) Process(job *model.CallJob) map[string]any {
+// Process runs the job to completion or until ctx is done. The returned error is the reason the job failed.
+func (a *App) Process(ctx context.Context, job *model.CallJob) error {
 	if job.Params.CallID == "" || job.Params.CallID == "0" {
-		return map[string]any{
-			"status": "error",
-			"error":  "call_id is required",
-		}
+		return errors.New("call_id is required")
 	}
 
-	if !a.State.TryAdd(job.Params.CallID) {
-		slog.Warn("Call ID is already being processed", slog.String("call_id", job.Params.CallID))
-		return map[string]any{
-			"status":  "already_processing",
-			"call_id": job.Params.CallID,
-		}
+	// the jobs of different rules audit the same call independently
+	key := fmt.Sprintf("%d:%s", job.RuleID, job.Params.CallID)
+	if !a.State.TryAdd(key) {
+		slog.Warn("Call ID is already being processed", slog.String("call_id", job.Params.CallID), slog.Int64("rule_id", job.RuleID))
+		return ErrAlreadyProcessing
 	}
+	defer a.State.Remove(key)
 
 	slog.Info("Accepted Call ID", slog.String("call_id", job.Params.CallID))
-	go func() {
-		if err := a.Runner.ProcessUUID(job); err != nil {
-			slog.Error("Failed to process Call ID", slog.String("call_id", job.Params.CallID), slog.String("error", err.Error()))
-		}
-		a.State.Remove(job.Params.CallID)
-
-		slog.Info("Finished processing Call ID", slog.String("call_id", job.Params.CallID))
-	}()
-
-	return map[string]any{
-		"status":  "accepted",
-		"call_id": job.Params.CallID,
+	if err := a.Runner.ProcessUUID(ctx, job); err != nil {
+		slog.Error("Failed to process Call ID", slog.String("call_id", job.Params.CallID), slog.String("error", err.Error()))
+		return err
 	}
+
+	slog.Info("Finished processing Call ID", slog.String("call_id", job.Params.CallID))
+	return nil
 }
@@ -0,0 +1,171 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAnthropicURL       = "https://api.anthropic.com"
+	defaultAnthropicModel     = "claude-sonnet-4-0"
+	defaultAnthropicMaxTokens = 4096
+	anthropicVersion          = "2023-06-01"
+)
+
+// anthropicProvider implements the Anthropic Messages API and any gateway compatible with it.
+// Structured output is emulated with a single forced tool whose input schema is the response schema.
+// Seed and reasoning effort have no equivalent in the Messages API and are ignored.
+type anthropicProvider struct {
+	baseURL string
+	apiKey  string
+	model   string
+	client  *http.Client
+}
+
+func newAnthropic(s Settings) *anthropicProvider {
+	base := s.BaseURL
+	if base == "" {
+		base = defaultAnthropicURL
+	}
+	return &anthropicProvider{
+		baseURL: strings.TrimRight(base, "/"),
+		apiKey:  s.APIKey,
+		model:   defaultModel(s.DefaultModel, defaultAnthropicModel),
+		client:  httpClient,
+	}
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	InputSchema map[string]any `json:"input_schema"`
+}
+
+type anthropicRequest struct {
+	Model       string             `json:"model"`
+	MaxTokens   int64              `json:"max_tokens"`
+	Temperature *float64           `json:"temperature,omitempty"`
+	TopP        *float64           `json:"top_p,omitempty"`
+	System      string             `json:"system,omitempty"`
+	Messages    []anthropicMessage `json:"messages"`
+	Tools       []anthropicTool    `json:"tools,omitempty"`
+	ToolChoice  map[string]any     `json:"tool_choice,omitempty"`
+}
+
+type anthropicResponse struct {
+	Model   string `json:"model"`
+	Content []struct {
+		Type  string          `json:"type"`
+		Text  string          `json:"text"`
+		Input json.RawMessage `json:"input"`
+	} `json:"content"`
+	Usage struct {
+		InputTokens  int64 `json:"input_tokens"`
+		OutputTokens int64 `json:"output_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (p *anthropicProvider) Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+	body := anthropicRequest{
+		Model:       defaultModel(req.Model, p.model),
+		MaxTokens:   defaultAnthropicMaxTokens,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+	}
+	if req.MaxOutputTokens != nil {
+		body.MaxTokens = *req.MaxOutputTokens
+	}
+	var system []string
+	for _, m := range req.Messages {
+		if m.Role == RoleSystem {
+			system = append(system, m.Content)
+			continue
+		}
+		body.Messages = append(body.Messages, anthropicMessage{Role: string(m.Role), Content: m.Content})
+	}
+	body.System = strings.Join(system, "\n\n")
+	if req.Schema != nil {
+		body.Tools = []anthropicTool{{
+			Name:        req.Schema.Name,
+			Description: "Return the result strictly in this structure.",
+			InputSchema: req.Schema.Schema,
+		}}
+		body.ToolChoice = map[string]any{"type": "tool", "name": req.Schema.Name}
+	}
+
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1/messages", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("anthropic-version", anthropicVersion)
+	if p.apiKey != "" {
+		httpReq.Header.Set("x-api-key", p.apiKey)
+	}
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	wait := retryAfter(resp.Header, time.Now())
+	var parsed anthropicResponse
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, &StatusError{Provider: KindAnthropic, StatusCode: resp.StatusCode, Message: string(raw), RetryAfter: wait}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if parsed.Error != nil {
+			return nil, &StatusError{Provider: KindAnthropic, StatusCode: resp.StatusCode, Message: parsed.Error.Type + ": " + parsed.Error.Message, RetryAfter: wait}
+		}
+		return nil, &StatusError{Provider: KindAnthropic, StatusCode: resp.StatusCode, Message: string(raw), RetryAfter: wait}
+	}
+
+	out := &ChatResponse{
+		Model: parsed.Model,
+		Usage: Usage{
+			PromptTokens:     parsed.Usage.InputTokens,
+			CompletionTokens: parsed.Usage.OutputTokens,
+		},
+	}
+	var text strings.Builder
+	for _, c := range parsed.Content {
+		switch c.Type {
+		case "tool_use":
+			if req.Schema != nil {
+				out.Content = string(c.Input)
+				return out, nil
+			}
+		case "text":
+			text.WriteString(c.Text)
+		}
+	}
+	if req.Schema != nil {
+		return nil, errors.New("anthropic: response has no structured output")
+	}
+	out.Content = text.String()
+	return out, nil
+}
@@ -0,0 +1,227 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned when the client side limit of the key does not let the request through in time.
+var ErrRateLimited = errors.New("llm: client rate limit")
+
+const (
+	// maxLimiterWait bounds how long a request waits for the limiter, the job is rescheduled then.
+	maxLimiterWait = 2 * time.Minute
+	// defaultOutputTokens is reserved for the answer of a request without MaxOutputTokens.
+	defaultOutputTokens = 1024
+)
+
+// Limits of the requests sent with an API key per minute, 0 is no limit.
+type Limits struct {
+	RequestsPerMin int
+	TokensPerMin   int
+}
+
+// limiters are shared by all the providers of a key.
+var limiters sync.Map
+
+// LimiterFor returns the limiter of the key, created with the limits on the first use.
+func LimiterFor(key string, limits Limits) *Limiter {
+	if l, ok := limiters.Load(key); ok {
+		return l.(*Limiter)
+	}
+	l, _ := limiters.LoadOrStore(key, NewLimiter(limits))
+	return l.(*Limiter)
+}
+
+// Limiter is a token bucket of the requests and of the tokens per minute of an API key.
+// A bucket starts full, so a burst of up to a minute of the limit goes through at once.
+type Limiter struct {
+	mu          sync.Mutex
+	requests    *bucket
+	tokens      *bucket
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+func NewLimiter(limits Limits) *Limiter {
+	l := &Limiter{now: time.Now}
+	now := l.now()
+	if limits.RequestsPerMin > 0 {
+		l.requests = newBucket(float64(limits.RequestsPerMin), now)
+	}
+	if limits.TokensPerMin > 0 {
+		l.tokens = newBucket(float64(limits.TokensPerMin), now)
+	}
+	return l
+}
+
+// Wait blocks until a request of the tokens is allowed. It returns ErrRateLimited without waiting
+// when the request would be allowed after maxLimiterWait or after the deadline of ctx.
+func (l *Limiter) Wait(ctx context.Context, tokens int64) error {
+	for {
+		delay := l.reserve(tokens)
+		if delay == 0 {
+			return nil
+		}
+		if delay > maxLimiterWait {
+			return ErrRateLimited
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return ErrRateLimited
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a request and the tokens when the buckets have them, otherwise it takes nothing
+// and returns how long to wait. A request larger than the tokens per minute waits for a full bucket.
+func (l *Limiter) reserve(tokens int64) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	var wait time.Duration
+	if l.requests != nil {
+		wait = max(wait, l.requests.wait(now, 1))
+	}
+	if l.tokens != nil {
+		wait = max(wait, l.tokens.wait(now, min(float64(tokens), l.tokens.capacity)))
+	}
+	if wait > 0 {
+		return wait
+	}
+	if l.requests != nil {
+		l.requests.available--
+	}
+	if l.tokens != nil {
+		l.tokens.available -= float64(tokens)
+	}
+	return 0
+}
+
+// Adjust corrects the reserved tokens by the difference between the used and the estimated tokens.
+func (l *Limiter) Adjust(delta int64) {
+	if l.tokens == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens.available = min(l.tokens.available-float64(delta), l.tokens.capacity)
+}
+
+// Pause holds the requests of the key for d, e.g. for the Retry-After of a rate limited response.
+func (l *Limiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := l.now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// bucket refills its capacity every minute.
+type bucket struct {
+	capacity  float64
+	available float64
+	perSec    float64
+	updatedAt time.Time
+}
+
+func newBucket(perMin float64, now time.Time) *bucket {
+	return &bucket{capacity: perMin, available: perMin, perSec: perMin / 60, updatedAt: now}
+}
+
+// wait refills the bucket and returns how long it takes to have n available.
+func (b *bucket) wait(now time.Time, n float64) time.Duration {
+	b.available = min(b.capacity, b.available+now.Sub(b.updatedAt).Seconds()*b.perSec)
+	b.updatedAt = now
+	if b.available >= n {
+		return 0
+	}
+	return time.Duration((n - b.available) / b.perSec * float64(time.Second))
+}
+
+// limitedProvider waits for the limiter of its key before every request.
+type limitedProvider struct {
+	Provider
+	limiter *Limiter
+}
+
+// WithLimiter makes the requests of the provider wait for the limiter. The limiter is paused
+// for the Retry-After of a rate limited response, so the other requests of the key back off too.
+func WithLimiter(p Provider, l *Limiter) Provider {
+	if l == nil || (l.requests == nil && l.tokens == nil) {
+		return p
+	}
+	return &limitedProvider{Provider: p, limiter: l}
+}
+
+func (p *limitedProvider) Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+	estimate := EstimateTokens(req)
+	if err := p.limiter.Wait(ctx, estimate); err != nil {
+		return nil, err
+	}
+	chat, err := p.Provider.Chat(ctx, req)
+	if err != nil {
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+			p.limiter.Pause(statusErr.RetryAfter)
+		}
+		return nil, err
+	}
+	p.limiter.Adjust(chat.Usage.PromptTokens + chat.Usage.CompletionTokens - estimate)
+	return chat, nil
+}
+
+// EstimateTokens guesses the tokens of the request before it is sent:
+// the tokens of the messages and the output tokens the answer may take.
+func EstimateTokens(req *ChatRequest) int64 {
+	var tokens int64
+	for _, m := range req.Messages {
+		tokens += TextTokens(m.Content)
+	}
+	output := int64(defaultOutputTokens)
+	if req.MaxOutputTokens != nil {
+		output = *req.MaxOutputTokens
+	}
+	return tokens + output
+}
+
+// TextTokens guesses the tokens of the text as about four bytes per token,
+// a Cyrillic letter takes two bytes.
+func TextTokens(text string) int64 {
+	return int64(len(text) / 4)
+}
+
+// retryAfter reads the delay the provider asks for in the retry-after-ms or Retry-After header,
+// Retry-After is either seconds or an HTTP date. 0 when there is none.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	if h == nil {
+		return 0
+	}
+	if ms, err := strconv.ParseFloat(h.Get("retry-after-ms"), 64); err == nil && ms > 0 {
+		return time.Duration(ms * float64(time.Millisecond))
+	}
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if sec, err := strconv.ParseFloat(v, 64); err == nil {
+		return max(time.Duration(sec*float64(time.Second)), 0)
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return 0
+}
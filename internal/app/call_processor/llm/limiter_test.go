@@ -0,0 +1,113 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(limits Limits, now *time.Time) *Limiter {
+	l := NewLimiter(limits)
+	l.now = func() time.Time { return *now }
+	for _, b := range []*bucket{l.requests, l.tokens} {
+		if b != nil {
+			b.updatedAt = *now
+		}
+	}
+	return l
+}
+
+func TestLimiterRequests(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestLimiter(Limits{RequestsPerMin: 2}, &now)
+
+	if d := l.reserve(0); d != 0 {
+		t.Fatalf("first request waits %v", d)
+	}
+	if d := l.reserve(0); d != 0 {
+		t.Fatalf("second request waits %v", d)
+	}
+	if d := l.reserve(0); d != 30*time.Second {
+		t.Fatalf("third request waits %v, want 30s", d)
+	}
+	now = now.Add(30 * time.Second)
+	if d := l.reserve(0); d != 0 {
+		t.Fatalf("request after the refill waits %v", d)
+	}
+}
+
+func TestLimiterTokens(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestLimiter(Limits{TokensPerMin: 600}, &now)
+
+	if d := l.reserve(500); d != 0 {
+		t.Fatalf("request within the bucket waits %v", d)
+	}
+	// 100 left, 200 more refill in 20s
+	if d := l.reserve(300); d != 20*time.Second {
+		t.Fatalf("request over the bucket waits %v, want 20s", d)
+	}
+	// the answer used 200 tokens less than reserved
+	l.Adjust(-200)
+	if d := l.reserve(300); d != 0 {
+		t.Fatalf("request after the adjustment waits %v", d)
+	}
+	// a request larger than the bucket waits for a full bucket only
+	now = now.Add(time.Minute)
+	if d := l.reserve(10_000); d != 0 {
+		t.Fatalf("request larger than the bucket waits %v", d)
+	}
+	// and leaves a debt of 9400 tokens, paid at 10 tokens a second
+	if d := l.reserve(60); d != 946*time.Second {
+		t.Fatalf("request after the debt waits %v, want 946s", d)
+	}
+}
+
+func TestLimiterPause(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestLimiter(Limits{RequestsPerMin: 60}, &now)
+
+	l.Pause(5 * time.Second)
+	if d := l.reserve(0); d != 5*time.Second {
+		t.Fatalf("paused request waits %v, want 5s", d)
+	}
+	now = now.Add(5 * time.Second)
+	if d := l.reserve(0); d != 0 {
+		t.Fatalf("request after the pause waits %v", d)
+	}
+}
+
+func TestLimiterWaitTooLong(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newTestLimiter(Limits{RequestsPerMin: 60}, &now)
+
+	l.Pause(maxLimiterWait + time.Second)
+	if err := l.Wait(context.Background(), 0); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Wait() = %v, want ErrRateLimited", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{name: "none", header: http.Header{}, want: 0},
+		{name: "seconds", header: http.Header{"Retry-After": {"20"}}, want: 20 * time.Second},
+		{name: "milliseconds first", header: http.Header{"Retry-After": {"1"}, "Retry-After-Ms": {"1500"}}, want: 1500 * time.Millisecond},
+		{name: "http date", header: http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}}, want: time.Minute},
+		{name: "date in the past", header: http.Header{"Retry-After": {now.Add(-time.Minute).Format(http.TimeFormat)}}, want: 0},
+		{name: "invalid", header: http.Header{"Retry-After": {"soon"}}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAfter(tt.header, now); got != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
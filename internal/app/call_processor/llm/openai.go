@@ -0,0 +1,148 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	openai "github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/shared"
+)
+
+const defaultAzureAPIVersion = "2024-10-21"
+
+// openAIProvider talks to any endpoint implementing the OpenAI chat completions API:
+// api.openai.com, Azure OpenAI deployments and self-hosted servers (vLLM, Ollama).
+type openAIProvider struct {
+	client openai.Client
+	model  string
+}
+
+func newOpenAI(s Settings) *openAIProvider {
+	opts := []option.RequestOption{option.WithHTTPClient(httpClient), option.WithAPIKey(s.APIKey)}
+	if s.BaseURL != "" {
+		opts = append(opts, option.WithBaseURL(s.BaseURL))
+	}
+	return &openAIProvider{
+		client: openai.NewClient(opts...),
+		model:  defaultModel(s.DefaultModel, openai.ChatModelGPT4o),
+	}
+}
+
+func newAzureOpenAI(s Settings) (*openAIProvider, error) {
+	if s.BaseURL == "" || s.Deployment == "" {
+		return nil, errors.New("azure openai requires base_url and deployment")
+	}
+	apiVersion := s.APIVersion
+	if apiVersion == "" {
+		apiVersion = defaultAzureAPIVersion
+	}
+	base := fmt.Sprintf("%s/openai/deployments/%s/", strings.TrimRight(s.BaseURL, "/"), s.Deployment)
+	return &openAIProvider{
+		client: openai.NewClient(
+			option.WithHTTPClient(httpClient),
+			option.WithBaseURL(base),
+			option.WithQuery("api-version", apiVersion),
+			option.WithHeader("api-key", s.APIKey),
+			option.WithHeaderDel("authorization"),
+		),
+		// the deployment decides the model, the field is informational only
+		model: defaultModel(s.DefaultModel, s.Deployment),
+	}, nil
+}
+
+func newOpenAICompatible(s Settings) (*openAIProvider, error) {
+	if s.BaseURL == "" {
+		return nil, errors.New("openai compatible provider requires base_url")
+	}
+	if s.DefaultModel == "" {
+		return nil, errors.New("openai compatible provider requires a model")
+	}
+	opts := []option.RequestOption{option.WithHTTPClient(httpClient), option.WithBaseURL(s.BaseURL)}
+	if s.APIKey != "" {
+		opts = append(opts, option.WithAPIKey(s.APIKey))
+	} else {
+		opts = append(opts, option.WithHeaderDel("authorization"))
+	}
+	return &openAIProvider{
+		client: openai.NewClient(opts...),
+		model:  s.DefaultModel,
+	}, nil
+}
+
+func (p *openAIProvider) Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error) {
+	params := openai.ChatCompletionNewParams{
+		Model:    defaultModel(req.Model, p.model),
+		Messages: make([]openai.ChatCompletionMessageParamUnion, 0, len(req.Messages)),
+	}
+	for _, m := range req.Messages {
+		switch m.Role {
+		case RoleSystem:
+			params.Messages = append(params.Messages, openai.SystemMessage(m.Content))
+		case RoleAssistant:
+			params.Messages = append(params.Messages, openai.AssistantMessage(m.Content))
+		default:
+			params.Messages = append(params.Messages, openai.UserMessage(m.Content))
+		}
+	}
+	if req.Temperature != nil {
+		params.Temperature = openai.Float(*req.Temperature)
+	}
+	if req.TopP != nil {
+		params.TopP = openai.Float(*req.TopP)
+	}
+	if req.MaxOutputTokens != nil {
+		params.MaxCompletionTokens = openai.Int(*req.MaxOutputTokens)
+	}
+	if req.Seed != nil {
+		params.Seed = openai.Int(*req.Seed)
+	}
+	if req.ReasoningEffort != "" {
+		params.ReasoningEffort = shared.ReasoningEffort(req.ReasoningEffort)
+	}
+	if req.Schema != nil {
+		params.ResponseFormat = openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+				JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   req.Schema.Name,
+					Strict: openai.Bool(req.Schema.Strict),
+					Schema: req.Schema.Schema,
+				},
+			},
+		}
+	}
+
+	chat, err := p.client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		var apiErr *openai.Error
+		if errors.As(err, &apiErr) {
+			statusErr := &StatusError{Provider: KindOpenAI, StatusCode: apiErr.StatusCode, Message: apiErr.Message}
+			if apiErr.Response != nil {
+				statusErr.RetryAfter = retryAfter(apiErr.Response.Header, time.Now())
+			}
+			return nil, statusErr
+		}
+		return nil, err
+	}
+	if len(chat.Choices) == 0 {
+		return nil, errors.New("chat completion has no choices")
+	}
+	return &ChatResponse{
+		Content: chat.Choices[0].Message.Content,
+		Model:   chat.Model,
+		Usage: Usage{
+			PromptTokens:     chat.Usage.PromptTokens,
+			CompletionTokens: chat.Usage.CompletionTokens,
+		},
+	}, nil
+}
+
+func defaultModel(model, def string) string {
+	if model != "" {
+		return model
+	}
+	return def
+}
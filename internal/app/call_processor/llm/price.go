@@ -0,0 +1,30 @@
+package llm
+
+import "strings"
+
+// Price of a model per million tokens.
+type Price struct {
+	Prompt     float64 `json:"prompt"`
+	Completion float64 `json:"completion"`
+}
+
+// Prices maps the models to their price, a model is priced by its exact name
+// or else by the longest name it starts with, so "gpt-4o" prices "gpt-4o-2024-08-06".
+type Prices map[string]Price
+
+// Cost returns the cost of the usage of the model, false when the model has no price.
+func (p Prices) Cost(model string, usage Usage) (float64, bool) {
+	price, ok := p[model]
+	if !ok {
+		var prefix string
+		for name, v := range p {
+			if len(name) > len(prefix) && strings.HasPrefix(model, name) {
+				prefix, price, ok = name, v, true
+			}
+		}
+	}
+	if !ok {
+		return 0, false
+	}
+	return (float64(usage.PromptTokens)*price.Prompt + float64(usage.CompletionTokens)*price.Completion) / 1e6, true
+}
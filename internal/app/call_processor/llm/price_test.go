@@ -0,0 +1,32 @@
+package llm
+
+import "testing"
+
+func TestPricesCost(t *testing.T) {
+	prices := Prices{
+		"gpt-4o":      {Prompt: 2.5, Completion: 10},
+		"gpt-4o-mini": {Prompt: 0.15, Completion: 0.6},
+	}
+	usage := Usage{PromptTokens: 1_000_000, CompletionTokens: 500_000}
+
+	tests := []struct {
+		name  string
+		model string
+		cost  float64
+		ok    bool
+	}{
+		{name: "exact", model: "gpt-4o", cost: 7.5, ok: true},
+		{name: "snapshot of a model", model: "gpt-4o-2024-08-06", cost: 7.5, ok: true},
+		{name: "longest prefix", model: "gpt-4o-mini-2024-07-18", cost: 0.45, ok: true},
+		{name: "unknown model", model: "claude-sonnet-4", cost: 0, ok: false},
+		{name: "empty model", model: "", cost: 0, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, ok := prices.Cost(tt.model, usage)
+			if ok != tt.ok || cost != tt.cost {
+				t.Errorf("Cost(%q) = %v, %v; want %v, %v", tt.model, cost, ok, tt.cost, tt.ok)
+			}
+		})
+	}
+}
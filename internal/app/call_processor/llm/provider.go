@@ -0,0 +1,138 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Provider kinds, stored in the cognitive profile "llm_provider" property.
+const (
+	KindOpenAI           = "openai"
+	KindAzureOpenAI      = "azure_openai"
+	KindAnthropic        = "anthropic"
+	KindOpenAICompatible = "openai_compatible"
+)
+
+// httpClient sends the requests of all the providers. The timeout is a backstop for a provider
+// that stops answering, the callers bound every request with a shorter deadline.
+var httpClient = &http.Client{Timeout: 3 * time.Minute}
+
+// Provider is a chat completion backend used by the call processor.
+type Provider interface {
+	// Chat sends a single chat completion request and returns the first choice.
+	Chat(ctx context.Context, req *ChatRequest) (*ChatResponse, error)
+}
+
+type Role string
+
+const (
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+)
+
+type Message struct {
+	Role    Role
+	Content string
+}
+
+// ResponseSchema requests structured output constrained by a JSON schema.
+type ResponseSchema struct {
+	Name   string
+	Schema map[string]any
+	Strict bool
+}
+
+type ChatRequest struct {
+	// Model overrides the provider default model when set.
+	Model    string
+	Messages []Message
+	// Schema switches the request to structured output; nil means free text.
+	Schema *ResponseSchema
+	// Generation parameters, nil leaves the provider default.
+	Temperature     *float64
+	TopP            *float64
+	MaxOutputTokens *int64
+	Seed            *int64
+	// ReasoningEffort is low, medium or high; ignored by providers without reasoning controls.
+	ReasoningEffort string
+}
+
+// StatusError is a non-2xx response of the provider API, the call processor retries
+// rate limited (429) and server (5xx) errors.
+type StatusError struct {
+	Provider   string
+	StatusCode int
+	Message    string
+	// RetryAfter is the delay the provider asks for before the next request, 0 when not given.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: status %d: %s", e.Provider, e.StatusCode, e.Message)
+}
+
+type Usage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+type ChatResponse struct {
+	Content string
+	Model   string
+	Usage   Usage
+}
+
+// Settings describe how to reach a provider. They are resolved per job
+// from the rule's language (token) and cognitive (endpoint) profiles.
+type Settings struct {
+	Kind         string
+	APIKey       string
+	BaseURL      string
+	APIVersion   string
+	Deployment   string
+	DefaultModel string
+}
+
+// New builds the provider for the given settings. An empty kind falls back to OpenAI.
+func New(s Settings) (Provider, error) {
+	switch NormalizeKind(s.Kind) {
+	case KindOpenAI:
+		return newOpenAI(s), nil
+	case KindAzureOpenAI:
+		return newAzureOpenAI(s)
+	case KindOpenAICompatible:
+		return newOpenAICompatible(s)
+	case KindAnthropic:
+		return newAnthropic(s), nil
+	default:
+		return nil, fmt.Errorf("unknown llm provider %q", s.Kind)
+	}
+}
+
+// NormalizeKind returns the canonical provider kind, an empty kind is OpenAI.
+func NormalizeKind(kind string) string {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	if kind == "" {
+		return KindOpenAI
+	}
+	return kind
+}
+
+// System is a shortcut for a system message.
+func System(content string) Message {
+	return Message{Role: RoleSystem, Content: content}
+}
+
+// User is a shortcut for a user message.
+func User(content string) Message {
+	return Message{Role: RoleUser, Content: content}
+}
+
+// Assistant is a shortcut for an assistant message.
+func Assistant(content string) Message {
+	return Message{Role: RoleAssistant, Content: content}
+}
@@ -0,0 +1,137 @@
+package processor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/model"
+)
+
+// AuditResult collects the outcome of a processed job stored in call_audit.results.
+// The result of a preview is returned only.
+type AuditResult struct {
+	Provider      string
+	Model         string
+	PromptVersion string
+	RawResponse   string
+	RateID        int64
+	Scores        []*int
+	Summary       string
+	Category      string
+	Usage         llm.Usage
+	Latency       time.Duration
+}
+
+// chat sends the request and accounts its usage and latency in the result.
+// The last response is kept as the raw response.
+func (r *Runner) chat(ctx context.Context, provider llm.Provider, req *llm.ChatRequest, job *model.CallJob, res *AuditResult) (*llm.ChatResponse, error) {
+	start := time.Now()
+	chat, err := provider.Chat(ctx, req)
+	res.Latency += time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+	res.Usage.PromptTokens += chat.Usage.PromptTokens
+	res.Usage.CompletionTokens += chat.Usage.CompletionTokens
+	res.RawResponse = chat.Content
+	if chat.Model != "" {
+		res.Model = chat.Model
+	}
+	r.saveUsage(ctx, job, res.Provider, res.Model, chat.Usage)
+	return chat, nil
+}
+
+// saveUsage stores the tokens and the cost of a chat completion of the job, a failure is logged only.
+// The usage of a preview is stored without a job, of an unsaved rule without a rule.
+func (r *Runner) saveUsage(ctx context.Context, job *model.CallJob, provider, modelName string, usage llm.Usage) {
+	if r.store == nil {
+		return
+	}
+	cost, ok := r.cfg.Prices.Cost(modelName, usage)
+	if !ok && len(r.cfg.Prices) > 0 {
+		slog.Warn("No price for the LLM model, the usage costs nothing", slog.String("model", modelName))
+	}
+	var jobID, ruleID *int64
+	if job.ID != 0 {
+		jobID = &job.ID
+	}
+	if job.RuleID != 0 {
+		ruleID = &job.RuleID
+	}
+	_, err := r.store.Execute(ctx, `
+		INSERT INTO call_audit.llm_usage(domain_id, rule_id, job_id, provider, model, prompt_tokens, completion_tokens, cost)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, job.Params.DomainID, ruleID, jobID, provider, modelName, usage.PromptTokens, usage.CompletionTokens, cost)
+	if err != nil {
+		slog.Error("Failed to save LLM usage", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
+	}
+}
+
+// promptVersion identifies the prompt template that produced a result: the kind
+// followed by a short hash of the instruction without the call transcript.
+func promptVersion(kind, instruction string) string {
+	sum := sha256.Sum256([]byte(instruction))
+	return kind + "@" + hex.EncodeToString(sum[:6])
+}
+
+// saveResult stores the result of the job, a failure is logged and does not fail the job.
+func (r *Runner) saveResult(ctx context.Context, job *model.CallJob, res *AuditResult) {
+	if r.store == nil {
+		return
+	}
+
+	var (
+		scores    []byte
+		total     *int
+		rateID    *int64
+		scorecard *int
+	)
+	if job.Params.Scorecard != 0 {
+		scorecard = &job.Params.Scorecard
+		items := make([]map[string]any, len(res.Scores))
+		for i, score := range res.Scores {
+			items[i] = map[string]any{"question": i, "score": score}
+		}
+		scores, _ = json.Marshal(items)
+		total = totalScore(res.Scores)
+	}
+	if res.RateID != 0 {
+		rateID = &res.RateID
+	}
+
+	_, err := r.store.Execute(ctx, `
+		INSERT INTO call_audit.results(domain_id, job_id, rule_id, call_id, scorecard_id, rate_id, provider, model, prompt_version,
+			raw_response, scores, score, summary, category, prompt_tokens, completion_tokens, latency_ms)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11::jsonb, $12, $13, $14, $15, $16, $17)
+	`, job.Params.DomainID, job.ID, job.RuleID, job.Params.CallID, scorecard, rateID, res.Provider, res.Model, res.PromptVersion,
+		res.RawResponse, nullJSON(scores), total, res.Summary, res.Category, res.Usage.PromptTokens, res.Usage.CompletionTokens,
+		res.Latency.Milliseconds())
+	if err != nil {
+		slog.Error("Failed to save audit result", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
+	}
+}
+
+// totalScore sums the answered questions, nil when none is answered.
+func totalScore(scores []*int) *int {
+	var total *int
+	for _, score := range scores {
+		if score != nil {
+			sum := deref(total) + *score
+			total = &sum
+		}
+	}
+	return total
+}
+
+func nullJSON(data []byte) *string {
+	if len(data) == 0 {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
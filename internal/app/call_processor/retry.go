@@ -0,0 +1,118 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/model"
+)
+
+var (
+	// ErrTranscriptNotReady is returned while the call has no transcript yet.
+	ErrTranscriptNotReady = errors.New("transcript is not ready")
+	// ErrInvalidOutput is returned when the model answers do not pass validation after the repair attempts.
+	ErrInvalidOutput = errors.New("invalid llm output")
+)
+
+// Error classes of a failed job, every class has its own retry delay.
+const (
+	ErrorClassTranscriptNotReady = "transcript_not_ready"
+	ErrorClassRateLimited        = "rate_limited"
+	ErrorClassInvalidOutput      = "invalid_output"
+	ErrorClassServer             = "server_error"
+	// ErrorClassPermanent is not retried.
+	ErrorClassPermanent = "permanent"
+)
+
+const maxRetryDelay = time.Hour
+
+// retryBaseDelay is the delay before the second attempt, doubled for every next one.
+var retryBaseDelay = map[string]time.Duration{
+	ErrorClassTranscriptNotReady: 2 * time.Minute,
+	ErrorClassRateLimited:        30 * time.Second,
+	ErrorClassInvalidOutput:      10 * time.Second,
+	ErrorClassServer:             30 * time.Second,
+}
+
+// HTTPStatusError is a non-2xx response of a webitel API.
+type HTTPStatusError struct {
+	Service    string
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s returned status %d: %s", e.Service, e.StatusCode, e.Body)
+}
+
+// ClassifyError returns the error class of a job failure.
+func ClassifyError(err error) string {
+	if err == nil {
+		return ""
+	}
+	if errors.Is(err, ErrTranscriptNotReady) {
+		return ErrorClassTranscriptNotReady
+	}
+	if errors.Is(err, ErrInvalidOutput) {
+		return ErrorClassInvalidOutput
+	}
+	if errors.Is(err, llm.ErrRateLimited) {
+		return ErrorClassRateLimited
+	}
+
+	var status int
+	var llmErr *llm.StatusError
+	var httpErr *HTTPStatusError
+	switch {
+	case errors.As(err, &llmErr):
+		status = llmErr.StatusCode
+	case errors.As(err, &httpErr):
+		status = httpErr.StatusCode
+	}
+	switch {
+	// 529 is the overloaded response of Anthropic
+	case status == http.StatusTooManyRequests || status == 529:
+		return ErrorClassRateLimited
+	case status >= 500:
+		return ErrorClassServer
+	case status != 0:
+		return ErrorClassPermanent
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorClassServer
+	}
+	return ErrorClassPermanent
+}
+
+// RetryDelay is the exponential backoff of the class after the given attempt (1 based).
+func RetryDelay(class string, attempt int) time.Duration {
+	delay, ok := retryBaseDelay[class]
+	if !ok {
+		return 0
+	}
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// NextAttempt returns when the failed job runs again, false when the error is permanent
+// or the job has used all of its JobMaxAttempts. The job waits at least the Retry-After of the provider.
+func (r *Runner) NextAttempt(job *model.CallJob, err error) (time.Time, bool) {
+	class := ClassifyError(err)
+	if class == ErrorClassPermanent || job.Attempts >= r.cfg.JobMaxAttempts {
+		return time.Time{}, false
+	}
+	delay := RetryDelay(class, job.Attempts)
+	var llmErr *llm.StatusError
+	if errors.As(err, &llmErr) {
+		delay = max(delay, min(llmErr.RetryAfter, maxRetryDelay))
+	}
+	return time.Now().Add(delay), true
+}
@@ -0,0 +1,103 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/model"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "no error", err: nil, want: ""},
+		{name: "transcript not ready", err: fmt.Errorf("no transcript: %w", ErrTranscriptNotReady), want: ErrorClassTranscriptNotReady},
+		{name: "invalid output", err: fmt.Errorf("scorecard: %w", ErrInvalidOutput), want: ErrorClassInvalidOutput},
+		{name: "client rate limit", err: fmt.Errorf("chat: %w", llm.ErrRateLimited), want: ErrorClassRateLimited},
+		{name: "too many requests", err: &llm.StatusError{StatusCode: 429}, want: ErrorClassRateLimited},
+		{name: "overloaded", err: &llm.StatusError{StatusCode: 529}, want: ErrorClassRateLimited},
+		{name: "provider server error", err: fmt.Errorf("chat: %w", &llm.StatusError{StatusCode: 502}), want: ErrorClassServer},
+		{name: "provider bad request", err: &llm.StatusError{StatusCode: 400}, want: ErrorClassPermanent},
+		{name: "webitel server error", err: &HTTPStatusError{Service: "engine", StatusCode: 503}, want: ErrorClassServer},
+		{name: "webitel not found", err: &HTTPStatusError{Service: "engine", StatusCode: 404}, want: ErrorClassPermanent},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: ErrorClassServer},
+		{name: "deadline", err: context.DeadlineExceeded, want: ErrorClassServer},
+		{name: "other error", err: errors.New("call_id is required"), want: ErrorClassPermanent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Errorf("ClassifyError(%v) = %q; want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		class   string
+		attempt int
+		want    time.Duration
+	}{
+		{name: "first attempt", class: ErrorClassServer, attempt: 1, want: 30 * time.Second},
+		{name: "doubled", class: ErrorClassServer, attempt: 3, want: 2 * time.Minute},
+		{name: "transcript not ready", class: ErrorClassTranscriptNotReady, attempt: 2, want: 4 * time.Minute},
+		{name: "capped", class: ErrorClassInvalidOutput, attempt: 20, want: maxRetryDelay},
+		{name: "attempt zero", class: ErrorClassRateLimited, attempt: 0, want: 30 * time.Second},
+		{name: "permanent", class: ErrorClassPermanent, attempt: 1, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RetryDelay(tt.class, tt.attempt); got != tt.want {
+				t.Errorf("RetryDelay(%q, %d) = %v; want %v", tt.class, tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextAttempt(t *testing.T) {
+	r := &Runner{cfg: &Config{JobMaxAttempts: 3}}
+
+	tests := []struct {
+		name     string
+		attempts int
+		err      error
+		delay    time.Duration
+		ok       bool
+	}{
+		{name: "retryable", attempts: 1, err: ErrTranscriptNotReady, delay: 2 * time.Minute, ok: true},
+		{name: "backoff", attempts: 2, err: ErrInvalidOutput, delay: 20 * time.Second, ok: true},
+		{name: "retry after of the provider", attempts: 1, err: &llm.StatusError{StatusCode: 429, RetryAfter: 5 * time.Minute}, delay: 5 * time.Minute, ok: true},
+		{name: "shorter retry after", attempts: 1, err: &llm.StatusError{StatusCode: 429, RetryAfter: time.Second}, delay: 30 * time.Second, ok: true},
+		{name: "capped retry after", attempts: 1, err: &llm.StatusError{StatusCode: 503, RetryAfter: 3 * time.Hour}, delay: maxRetryDelay, ok: true},
+		{name: "no attempts left", attempts: 3, err: ErrTranscriptNotReady, ok: false},
+		{name: "permanent", attempts: 1, err: &llm.StatusError{StatusCode: 401}, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now()
+			runAt, ok := r.NextAttempt(&model.CallJob{Attempts: tt.attempts}, tt.err)
+			if ok != tt.ok {
+				t.Fatalf("NextAttempt() ok = %v; want %v", ok, tt.ok)
+			}
+			if !ok {
+				if !runAt.IsZero() {
+					t.Errorf("NextAttempt() = %v; want the zero time", runAt)
+				}
+				return
+			}
+			if delay := runAt.Sub(before); delay < tt.delay || delay > tt.delay+time.Second {
+				t.Errorf("NextAttempt() delay = %v; want %v", delay, tt.delay)
+			}
+		})
+	}
+}
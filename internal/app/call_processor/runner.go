@@ -8,34 +8,78 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/internal/store"
 	"github.com/webitel/call_audit/model"
-
-	openai "github.com/openai/openai-go"
-	"github.com/openai/openai-go/option"
 )
 
 type Runner struct {
-	cfg *Config
+	cfg    *Config
+	store  store.ServiceStore
+	events EventPublisher
+}
+
+func NewRunner(cfg *Config, serviceStore store.ServiceStore, events EventPublisher) *Runner {
+	return &Runner{cfg: cfg, store: serviceStore, events: events}
+}
+
+// ProcessUUID audits the call of the job and publishes the outcome, completed or finally failed.
+// A job aborted by ctx publishes nothing, its next run does.
+func (r *Runner) ProcessUUID(ctx context.Context, job *model.CallJob) error {
+	res := &AuditResult{
+		Provider: llm.NormalizeKind(deref(job.Params.Provider)),
+		Model:    deref(job.Params.Model),
+	}
+	err := r.processUUID(ctx, job, res, false)
+	if ctx.Err() != nil {
+		return err
+	}
+	// a failure that is retried is published by the last attempt only
+	if _, retry := r.NextAttempt(job, err); err == nil || !retry {
+		r.publishOutcome(job, res, err)
+	}
+	return err
 }
 
-func NewRunner(cfg *Config) *Runner {
-	return &Runner{cfg: cfg}
+// Preview audits the transcribed call of the job like ProcessUUID without ordering the transcript,
+// writing the variables, posting the rating, storing the result or publishing the outcome. The LLM requests stop once ctx is done.
+func (r *Runner) Preview(ctx context.Context, job *model.CallJob) (*AuditResult, error) {
+	res := &AuditResult{
+		Provider: llm.NormalizeKind(deref(job.Params.Provider)),
+		Model:    deref(job.Params.Model),
+	}
+	err := r.processUUID(ctx, job, res, true)
+	return res, err
 }
 
-func (r *Runner) ProcessUUID(job *model.CallJob) error {
+// processUUID audits the call of the job into res, a dry run stops before the audit is written anywhere.
+func (r *Runner) processUUID(ctx context.Context, job *model.CallJob, res *AuditResult, dryRun bool) error {
 	slog.Info("Starting processing UUID", slog.String("uuid", job.Params.CallID))
 
-	transcriptID, fromName, toName := r.fetchTranscriptInfoWithRetries(job.Params.CallID)
+	// a dry run audits a call transcribed already, it does not order the transcript
+	var transcriptID, fromName, toName string
+	if dryRun {
+		transcriptID, fromName, toName = r.fetchTranscriptInfo(ctx, job.Params.CallID)
+	} else {
+		transcriptID, fromName, toName = r.fetchTranscriptInfoWithRetries(ctx, job.Params.CallID)
+	}
 	if transcriptID == "" {
 		slog.Warn("No transcript ID returned", slog.String("uuid", job.Params.CallID))
-		return fmt.Errorf("no transcript ID found for UUID %s", job.Params.CallID)
+		return fmt.Errorf("no transcript ID found for UUID %s: %w", job.Params.CallID, ErrTranscriptNotReady)
+	}
+
+	provider, err := r.provider(job)
+	if err != nil {
+		slog.Error("Failed to build LLM provider", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
+		return err
 	}
 
-	phrases := r.getPhrases(transcriptID, job.Params.CallID)
-	dialogue := buildDialogue(phrases, fromName, toName)
+	phrases := parsePhrases(r.getPhrases(transcriptID, job.Params.CallID), fromName, toName)
+	chunks := r.chunks(job, phrases)
 
 	if job.Params.Scorecard != 0 {
 		scorecard, err := r.fetchScorecardForm(job.Params.Scorecard)
@@ -43,123 +87,225 @@ func (r *Runner) ProcessUUID(job *model.CallJob) error {
 			slog.Error("Failed to fetch scorecard form", slog.String("scorecard_id", fmt.Sprint(job.Params.Scorecard)), slog.String("error", err.Error()))
 			return err
 		}
-		prompt := buildScorecardPrompt(dialogue, scorecard, *job.Params.SaveExplanation)
-		scores, comment := r.callOpenAIForScorecard(prompt, job)
-		r.sendScorecardAnswers(job, scores, comment)
+		explain := deref(job.Params.SaveExplanation)
+		prompt := buildScorecardPrompt(buildDialogue(phrases), scorecard, explain)
+		res.PromptVersion = promptVersion("scorecard", buildScorecardPrompt("", scorecard, explain))
+		if len(chunks) > 1 {
+			notes, err := r.mapChunks(ctx, provider, "Ти аудитор якості дзвінків. Аналізуй за формою.", chunks, func(part int, dialogue string) string {
+				return buildScorecardChunkPrompt(part, len(chunks), dialogue, scorecard)
+			}, job, res)
+			if err != nil {
+				return err
+			}
+			prompt = buildScorecardReducePrompt(notes, scorecard, explain)
+			res.PromptVersion = promptVersion("scorecard_map_reduce", buildScorecardReducePrompt("", scorecard, explain))
+		}
+		result, err := r.evaluateScorecard(ctx, provider, scorecard, phrases, prompt, job, res)
+		if err != nil {
+			slog.Error("Failed to evaluate scorecard", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
+			return err
+		}
+		if dryRun {
+			res.Scores = result.Answers
+			return nil
+		}
+		comment := result.Comment
+		if explain {
+			comment = formatScorecardComment(result.Comment, result.Explanations)
+		}
+		rateID, err := r.sendScorecardAnswers(job, result.Answers, comment)
+		if err != nil {
+			return err
+		}
+		if explain {
+			r.saveScorecardExplanations(ctx, job, scorecard, rateID, result)
+		}
+		res.RateID = rateID
+		res.Scores = result.Answers
+		r.saveResult(ctx, job, res)
 		return nil
 	}
 
-	summary, category := r.summarizeTranscript(dialogue, fromName, toName, job)
+	summary, category, err := r.summarizeTranscript(ctx, provider, chunks, job, res)
+	if err != nil {
+		return err
+	}
+	res.Summary, res.Category = summary, category
+	if dryRun {
+		return nil
+	}
 	r.patchSummary(job.Params.CallID, summary, category)
+	r.saveResult(ctx, job, res)
 	return nil
 }
 
-func buildDialogue(phrases []map[string]any, from, to string) string {
-	var dialogue strings.Builder
-	for _, p := range phrases {
-		phrase, _ := p["phrase"].(string)
-		channel, _ := p["channel"].(float64)
-		name := fmt.Sprintf("Спікер %d", int(channel))
-		if int(channel) == 0 && from != "" {
-			name = from
-		} else if int(channel) == 1 && to != "" {
-			name = to
-		}
-		dialogue.WriteString(fmt.Sprintf("%s: %s\n", name, phrase))
+// provider resolves the LLM backend configured by the rule's cognitive profile.
+// The requests wait for the limiter of the API key shared by all the jobs of this instance.
+func (r *Runner) provider(job *model.CallJob) (llm.Provider, error) {
+	apiKey := deref(job.Params.Token)
+	if apiKey == "" {
+		apiKey = r.cfg.OpenAIApiKey
+	}
+	settings := llm.Settings{
+		Kind:       deref(job.Params.Provider),
+		APIKey:     apiKey,
+		BaseURL:    deref(job.Params.ProviderURL),
+		APIVersion: deref(job.Params.ProviderAPIVersion),
+		Deployment: deref(job.Params.ProviderDeployment),
+		// an openai compatible server has no default model, the model of the rule is required
+		DefaultModel: deref(job.Params.Model),
+	}
+	provider, err := llm.New(settings)
+	if err != nil {
+		return nil, err
+	}
+	key := llm.NormalizeKind(settings.Kind) + "|" + settings.BaseURL + "|" + settings.APIKey
+	return llm.WithLimiter(provider, llm.LimiterFor(key, r.cfg.Limits)), nil
+}
+
+// chatRequest applies the rule's model and generation parameters to the messages.
+// Temperature falls back to OPENAI_TEMPERATURE unless the rule targets a reasoning model.
+func (r *Runner) chatRequest(job *model.CallJob, messages ...llm.Message) *llm.ChatRequest {
+	req := &llm.ChatRequest{
+		Model:           deref(job.Params.Model),
+		Messages:        messages,
+		Temperature:     job.Params.Temperature,
+		TopP:            job.Params.TopP,
+		MaxOutputTokens: job.Params.MaxOutputTokens,
+		Seed:            job.Params.Seed,
+		ReasoningEffort: deref(job.Params.ReasoningEffort),
+	}
+	if req.Temperature == nil && req.ReasoningEffort == "" {
+		temperature := r.cfg.OpenAITemperature
+		req.Temperature = &temperature
+	}
+	return req
+}
+
+func deref[T any](v *T) T {
+	var def T
+	if v == nil {
+		return def
 	}
-	return dialogue.String()
+	return *v
 }
 
 func buildScorecardPrompt(dialogue string, form *model.ScorecardForm, explain bool) string {
+	return scorecardPrompt("Дзвінок:\n"+dialogue, form, explain)
+}
+
+// buildScorecardReducePrompt answers the form from the notes of the parts of a long call.
+func buildScorecardReducePrompt(notes string, form *model.ScorecardForm, explain bool) string {
+	return scorecardPrompt("Дзвінок довгий, тому його розібрано частинами. Нотатки по частинах з цитатами фраз:\n"+notes, form, explain)
+}
+
+func scorecardPrompt(call string, form *model.ScorecardForm, explain bool) string {
 	var b strings.Builder
 
 	b.WriteString("Оціни наступний дзвінок за анкетою.")
 	if explain {
-		b.WriteString(" Для кожної відповіді коротко поясни, чому саме цю оцінку надано.")
+		b.WriteString(" Для кожної відповіді коротко поясни в rationale, чому саме цю оцінку надано," +
+			" і додай в evidence дослівні цитати з дзвінка, які це підтверджують, з номером фрази в квадратних дужках.")
 	}
-	b.WriteString(" Повертай результат у форматі JSON.\n\n")
+	b.WriteString("\n\n")
 
-	b.WriteString("Дзвінок:\n")
-	b.WriteString(dialogue)
+	b.WriteString(call)
 	b.WriteString("\n\nАнкета:\n")
+	writeScorecardQuestions(&b, form)
+
+	b.WriteString("\nВідповідь на кожне питання поверни в answers під його ключем (q1, q2, ...).")
+	if explain {
+		b.WriteString(" У comment дай короткий загальний висновок.\n")
+	} else {
+		b.WriteString(" У comment поверни \"-\".\n")
+	}
+	return b.String()
+}
 
+func writeScorecardQuestions(b *strings.Builder, form *model.ScorecardForm) {
 	for i, q := range form.Questions {
-		b.WriteString(fmt.Sprintf("%d. %s\n", i+1, q.Question))
+		b.WriteString(fmt.Sprintf("%s. %s", questionKey(i), q.Question))
+		if !q.Required {
+			b.WriteString(" (необовʼязкове, якщо відповісти неможливо — null)")
+		}
+		b.WriteString("\n")
 		switch q.Type {
-		case "question_option":
-			b.WriteString("Вибери один з точних варіантів:")
+		case questionTypeOption:
+			b.WriteString("Вибери один з точних варіантів:\n")
 			for _, opt := range q.Options {
 				b.WriteString(fmt.Sprintf(" - %s (%d)\n", opt.Name, opt.Score))
 			}
-			b.WriteString(" — будь-яке інше значення не допускається.")
-		case "question_score":
+			b.WriteString("Будь-яке інше значення не допускається.\n")
+		case questionTypeScore:
 			b.WriteString(fmt.Sprintf("Оцінка від %d до %d\n", q.Min, q.Max))
 		}
 	}
-
-	if explain {
-		b.WriteString("\nТекст пояснення формуй для кожного питання з нового рядка, прономеруй пояснення\n")
-		b.WriteString("\nФормат відповіді:\n")
-		b.WriteString(`{"answers":[{"score":10},...],"comment":"текст пояснення"}`)
-	} else {
-		b.WriteString("\nФормат відповіді:\n")
-		b.WriteString(`{"answers":[{"score":10},...],"comment":"-"}`)
-	}
-	return b.String()
 }
 
-func (r *Runner) callOpenAIForScorecard(prompt string, job *model.CallJob) ([]int, string) {
-	client := openai.NewClient(option.WithAPIKey(*job.Params.Token))
-
-	chat, err := client.Chat.Completions.New(context.Background(), openai.ChatCompletionNewParams{
-		Model: openai.ChatModelGPT4o,
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.SystemMessage("Ти аудитор якості дзвінків. Аналізуй за формою."),
-			openai.UserMessage(prompt),
-		},
-		//Temperature: r.cfg.OpenAITemperature,
-		//ReasoningEffort: openai.ReasoningEffortLow,
-	})
-
-	if err != nil {
-		slog.Error("OpenAI request failed (scorecard)", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
-		return nil, ""
-	}
-
-	if len(chat.Choices) == 0 {
-		return nil, ""
-	}
-
-	content := chat.Choices[0].Message.Content
-	// remove markdown
-	cleaned := strings.TrimSpace(content)
-	cleaned = strings.TrimPrefix(cleaned, "```json")
-	cleaned = strings.TrimPrefix(cleaned, "```")
-	cleaned = strings.TrimSuffix(cleaned, "```")
-	cleaned = strings.TrimSpace(cleaned)
+// scorecardRequestTimeout bounds a single scorecard request, the structured answers
+// of a long form take longer than a summary.
+const scorecardRequestTimeout = 2 * time.Minute
+
+// evaluateScorecard requests schema-constrained answers for the form and validates them.
+// Invalid answers are sent back to the model with the list of violations up to ScorecardRepairAttempts times.
+func (r *Runner) evaluateScorecard(ctx context.Context, provider llm.Provider, form *model.ScorecardForm, phrases []transcriptPhrase, prompt string, job *model.CallJob, res *AuditResult) (*scorecardResult, error) {
+	explain := deref(job.Params.SaveExplanation)
+	req := r.chatRequest(job,
+		llm.System("Ти аудитор якості дзвінків. Аналізуй за формою."),
+		llm.User(prompt),
+	)
+	req.Schema = buildScorecardSchema(form, explain)
+
+	for attempt := 0; ; attempt++ {
+		reqCtx, cancel := context.WithTimeout(ctx, scorecardRequestTimeout)
+		chat, err := r.chat(reqCtx, provider, req, job, res)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("llm request failed: %w", err)
+		}
 
-	var parsed struct {
-		Answers []struct {
-			Score int `json:"score"`
-		} `json:"answers"`
-		Comment string `json:"comment"`
-	}
-	if err := json.Unmarshal([]byte(cleaned), &parsed); err != nil {
-		slog.Error("Failed to parse OpenAI scorecard JSON", slog.String("uuid", job.Params.CallID), slog.String("raw", cleaned))
-		return nil, ""
+		result, err := parseScorecardResult(form, phrases, chat.Content, explain)
+		if err == nil {
+			return result, nil
+		}
+		slog.Warn("Invalid LLM scorecard answers",
+			slog.String("uuid", job.Params.CallID),
+			slog.Int("attempt", attempt+1),
+			slog.String("raw", chat.Content),
+			slog.String("error", err.Error()))
+
+		if attempt >= r.cfg.ScorecardRepairAttempts {
+			return nil, fmt.Errorf("%w: invalid scorecard answers after %d attempts: %w", ErrInvalidOutput, attempt+1, err)
+		}
+		req.Messages = append(req.Messages,
+			llm.Assistant(chat.Content),
+			llm.User(buildRepairPrompt(err)),
+		)
 	}
+}
 
-	scores := make([]int, len(parsed.Answers))
-	for i, a := range parsed.Answers {
-		scores[i] = a.Score
+// buildRepairPrompt asks the model to correct the specific violations of its previous answer.
+func buildRepairPrompt(violations error) string {
+	var b strings.Builder
+	b.WriteString("Попередня відповідь не відповідає анкеті:\n")
+	for _, v := range strings.Split(violations.Error(), "; ") {
+		b.WriteString("- ")
+		b.WriteString(v)
+		b.WriteString("\n")
 	}
-	return scores, parsed.Comment
+	b.WriteString("Виправ ці помилки і поверни повну відповідь на всі питання анкети.")
+	return b.String()
 }
 
-func (r *Runner) sendScorecardAnswers(job *model.CallJob, scores []int, comment string) {
-	answers := make([]map[string]int, len(scores))
+// sendScorecardAnswers posts the rating to the audit service and returns the id of the created rate.
+func (r *Runner) sendScorecardAnswers(job *model.CallJob, scores []*int, comment string) (int64, error) {
+	answers := make([]map[string]any, len(scores))
 	for i, score := range scores {
-		answers[i] = map[string]int{"score": score}
+		if score == nil {
+			// skipped optional question
+			continue
+		}
+		answers[i] = map[string]any{"score": *score}
 	}
 
 	payload := map[string]any{
@@ -181,38 +327,79 @@ func (r *Runner) sendScorecardAnswers(job *model.CallJob, scores []int, comment
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		slog.Error("Failed to send scorecard answers", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
-		return
+		return 0, err
 	}
 	defer resp.Body.Close()
 
 	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		slog.Info("Scorecard answers sent", slog.String("uuid", job.Params.CallID), slog.Int("status", resp.StatusCode), slog.String("body", string(body)))
-	} else {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		slog.Error("Failed to send scorecard answers", slog.String("uuid", job.Params.CallID), slog.Int("status", resp.StatusCode), slog.String("body", string(body)))
+		return 0, &HTTPStatusError{Service: "audit rate", StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	slog.Info("Scorecard answers sent", slog.String("uuid", job.Params.CallID), slog.Int("status", resp.StatusCode), slog.String("body", string(body)))
+
+	// int64 ids are encoded as strings by the gateway
+	var rate struct {
+		ID json.RawMessage `json:"id"`
+	}
+	_ = json.Unmarshal(body, &rate)
+	id, _ := strconv.ParseInt(strings.Trim(string(rate.ID), `"`), 10, 64)
+	return id, nil
+}
+
+// saveScorecardExplanations stores the rationale and evidence of every answer next to the rate,
+// so the exact moments of the recording that justified a score can be found later.
+func (r *Runner) saveScorecardExplanations(ctx context.Context, job *model.CallJob, form *model.ScorecardForm, rateID int64, result *scorecardResult) {
+	if r.store == nil {
+		return
+	}
+	for i, e := range result.Explanations {
+		// the column is NOT NULL, an answer without quotes has an empty list
+		evidence := []byte("[]")
+		if len(e.Evidence) > 0 {
+			evidence, _ = json.Marshal(e.Evidence)
+		}
+		var rate *int64
+		if rateID != 0 {
+			rate = &rateID
+		}
+		_, err := r.store.Execute(ctx, `
+			INSERT INTO call_audit.scorecard_explanations(job_id, rule_id, call_id, scorecard_id, rate_id, question, question_text, score, rationale, evidence)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::jsonb)
+		`, job.ID, job.RuleID, job.Params.CallID, job.Params.Scorecard, rate, i, form.Questions[i].Question, result.Answers[i], e.Rationale, string(evidence))
+		if err != nil {
+			slog.Error("Failed to save scorecard explanation", slog.String("uuid", job.Params.CallID), slog.Int("question", i), slog.String("error", err.Error()))
+			return
+		}
 	}
 }
 
-func (r *Runner) fetchTranscriptInfoWithRetries(uuid string) (string, string, string) {
-	r.createTranscript(uuid)
+// fetchTranscriptInfoWithRetries orders the transcript of the call and waits for it until the retries
+// are used or ctx is done.
+func (r *Runner) fetchTranscriptInfoWithRetries(ctx context.Context, uuid string) (string, string, string) {
+	r.createTranscript(ctx, uuid)
 	for i := 1; i <= r.cfg.MaxRetries; i++ {
-		id, from, to := r.fetchTranscriptInfo(uuid)
+		id, from, to := r.fetchTranscriptInfo(ctx, uuid)
 		if id != "" {
 			return id, from, to
 		}
 		slog.Info("Retrying fetchTranscriptInfo", slog.String("uuid", uuid), slog.Int("attempt", i))
-		time.Sleep(time.Duration(r.cfg.DelayBetweenRetries * float64(time.Second)))
+		select {
+		case <-ctx.Done():
+			return "", "", ""
+		case <-time.After(time.Duration(r.cfg.DelayBetweenRetries * float64(time.Second))):
+		}
 	}
 	return "", "", ""
 }
 
-func (r *Runner) createTranscript(uuid string) {
+func (r *Runner) createTranscript(ctx context.Context, uuid string) {
 	payload := map[string]any{
 		"uuid": []string{uuid},
 	}
 
 	jsonBody, _ := json.Marshal(payload)
-	req, _ := http.NewRequest("POST", r.cfg.PostTranscriptURL, bytes.NewReader(jsonBody))
+	req, _ := http.NewRequestWithContext(ctx, "POST", r.cfg.PostTranscriptURL, bytes.NewReader(jsonBody))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Webitel-Access", r.cfg.AccessToken)
 
@@ -224,7 +411,7 @@ func (r *Runner) createTranscript(uuid string) {
 	defer resp.Body.Close()
 }
 
-func (r *Runner) fetchTranscriptInfo(uuid string) (string, string, string) {
+func (r *Runner) fetchTranscriptInfo(ctx context.Context, uuid string) (string, string, string) {
 	payload := map[string]any{
 		"sort": "-created_at",
 		"fields": []string{
@@ -240,7 +427,7 @@ func (r *Runner) fetchTranscriptInfo(uuid string) (string, string, string) {
 	}
 
 	jsonBody, _ := json.Marshal(payload)
-	req, _ := http.NewRequest("POST", r.cfg.PostHistoryURL, bytes.NewReader(jsonBody))
+	req, _ := http.NewRequestWithContext(ctx, "POST", r.cfg.PostHistoryURL, bytes.NewReader(jsonBody))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Webitel-Access", r.cfg.AccessToken)
 
@@ -321,69 +508,55 @@ func (r *Runner) getPhrases(transcriptID, uuid string) []map[string]any {
 	return result.Items
 }
 
-func (r *Runner) summarizeTranscript(dialogue, from, to string, job *model.CallJob) (string, string) {
-
-	prompt := ""
+// summarizeTranscript summarizes and classifies the call. A call of several chunks is summarized
+// from the summaries of its chunks.
+func (r *Runner) summarizeTranscript(ctx context.Context, provider llm.Provider, chunks [][]transcriptPhrase, job *model.CallJob, res *AuditResult) (string, string, error) {
+	instruction := r.cfg.OpenAIPrompt
 	if job.Params.DefaultPrompt != nil && *job.Params.DefaultPrompt != "" {
-		prompt = fmt.Sprintf("\nОсь розмова:\n%s\n\nВідповідь повертай у форматі:\nSummary: <текст>\nCategory: <одна з %s>",
-			dialogue,
-			strings.Join(r.cfg.OpenAICategories, ", "))
+		instruction = *job.Params.DefaultPrompt
+	}
+	categories := strings.Join(r.cfg.OpenAICategories, ", ")
+	call := "Ось розмова:\n" + buildDialogue(chunks[0])
+	res.PromptVersion = promptVersion("summary", instruction+"\n"+categories)
+	if len(chunks) > 1 {
+		notes, err := r.mapChunks(ctx, provider, "Ти узагальнювач дзвінків.", chunks, func(part int, dialogue string) string {
+			return buildSummaryChunkPrompt(part, len(chunks), dialogue)
+		}, job, res)
+		if err != nil {
+			return "", "", err
+		}
+		call = "Розмова довга, ось підсумки її частин:\n" + notes
+		res.PromptVersion = promptVersion("summary_map_reduce", instruction+"\n"+categories)
 	}
+	prompt := fmt.Sprintf("%s\n%s\n\nВідповідь повертай у форматі:\nSummary: <текст>\nCategory: <одна з %s>",
+		instruction,
+		call,
+		categories)
 
-	/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-	openaiClient := openai.NewClient(option.WithAPIKey(*job.Params.Token))
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
-	// Create the chat completion request
-	chatCompletion, err := openaiClient.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-		Model: openai.ChatModelGPT4o,
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.SystemMessage("Ти класифікатор дзвінків та узагальнювач."),
-			openai.UserMessage(prompt),
-		},
-	})
+	chat, err := r.chat(ctx, provider, r.chatRequest(job,
+		llm.System("Ти класифікатор дзвінків та узагальнювач."),
+		llm.User(prompt),
+	), job, res)
 	if err != nil {
-		slog.Error("OpenAI request failed (summary)", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
-		return "", ""
-	}
-	// Check if we got a valid response
-	if len(chatCompletion.Choices) == 0 {
-		slog.Error("OpenAI response has no choices", slog.String("uuid", job.Params.CallID))
-		return "", ""
-	}
-
-	//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-	var result struct {
-		Choices []struct {
-			Message struct {
-				Content string `json:"content"`
-			} `json:"message"`
-		} `json:"choices"`
-	}
-	// if err := json.Unmarshal(body, &result); err != nil {
-	// 	slog.Error("OpenAI JSON parse error", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
-	// 	return "", ""
-	// }
-
-	if len(result.Choices) == 0 {
-		return "", ""
+		slog.Error("LLM request failed (summary)", slog.String("uuid", job.Params.CallID), slog.String("error", err.Error()))
+		return "", "", fmt.Errorf("llm request failed: %w", err)
 	}
 
-	content := result.Choices[0].Message.Content
-	lines := strings.Split(content, "\n")
+	lines := strings.Split(chat.Content, "\n")
 	summary := ""
 	category := ""
 	for _, line := range lines {
 		l := strings.ToLower(line)
 		if strings.HasPrefix(l, "summary:") {
-			summary = strings.TrimSpace(strings.TrimPrefix(line, "Summary:"))
+			summary = strings.TrimSpace(line[len("summary:"):])
 		}
 		if strings.HasPrefix(l, "category:") {
-			category = strings.TrimSpace(strings.TrimPrefix(line, "Category:"))
+			category = strings.TrimSpace(line[len("category:"):])
 		}
 	}
-	return summary, category
+	return summary, category, nil
 }
 
 func (r *Runner) fetchScorecardForm(scorecardID int) (*model.ScorecardForm, error) {
@@ -407,7 +580,7 @@ func (r *Runner) fetchScorecardForm(scorecardID int) (*model.ScorecardForm, erro
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		slog.Error("Non-200 status code for scorecard form", slog.String("scorecard_id", fmt.Sprint(scorecardID)), slog.Int("status_code", resp.StatusCode), slog.String("body", string(body)))
-		return nil, fmt.Errorf("non-200 status: %d, body: %s", resp.StatusCode, body)
+		return nil, &HTTPStatusError{Service: "audit form", StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	var form model.ScorecardForm
@@ -0,0 +1,76 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/model"
+)
+
+func strPtr(v string) *string {
+	return &v
+}
+
+func TestRunnerProviderOpenAICompatible(t *testing.T) {
+	var gotModel, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			Model string `json:"model"`
+		}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		gotModel, gotAuth = body.Model, req.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","created":0,"model":"llama3",` +
+			`"choices":[{"index":0,"message":{"role":"assistant","content":"ok"},"finish_reason":"stop"}],` +
+			`"usage":{"prompt_tokens":3,"completion_tokens":1,"total_tokens":4}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		params  model.JobParams
+		wantErr bool
+	}{
+		{
+			name:   "model of the rule",
+			params: model.JobParams{Provider: strPtr(llm.KindOpenAICompatible), ProviderURL: strPtr(server.URL), Model: strPtr("llama3")},
+		},
+		{
+			name:    "without a model",
+			params:  model.JobParams{Provider: strPtr(llm.KindOpenAICompatible), ProviderURL: strPtr(server.URL)},
+			wantErr: true,
+		},
+		{
+			name:    "without a base url",
+			params:  model.JobParams{Provider: strPtr(llm.KindOpenAICompatible), Model: strPtr("llama3")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Runner{cfg: &Config{}}
+			job := &model.CallJob{Params: tt.params}
+			provider, err := r.provider(job)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("provider() error = %v; want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			chat, err := provider.Chat(context.Background(), r.chatRequest(job, llm.User("hi")))
+			if err != nil {
+				t.Fatalf("Chat() error = %v", err)
+			}
+			if chat.Content != "ok" || gotModel != "llama3" {
+				t.Errorf("Chat() = %q with model %q; want %q with model %q", chat.Content, gotModel, "ok", "llama3")
+			}
+			if gotAuth != "" {
+				t.Errorf("Authorization = %q; want none without an API key", gotAuth)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+	"github.com/webitel/call_audit/model"
+)
+
+const (
+	questionTypeOption = "question_option"
+	questionTypeScore  = "question_score"
+)
+
+// scorecardResult is the parsed model answer for a scorecard form,
+// Answers are ordered as the form questions, nil for a skipped optional question.
+// Explanations are set only when the rule saves explanations and follow the same order.
+type scorecardResult struct {
+	Answers      []*int
+	Explanations []scorecardExplanation
+	Comment      string
+}
+
+type scorecardAnswer struct {
+	Score     *int                `json:"score"`
+	Rationale string              `json:"rationale,omitempty"`
+	Evidence  []scorecardEvidence `json:"evidence,omitempty"`
+}
+
+// scorecardExplanation justifies a single answer.
+type scorecardExplanation struct {
+	Rationale string              `json:"rationale"`
+	Evidence  []scorecardEvidence `json:"evidence"`
+}
+
+// scorecardEvidence is a verbatim quote of a transcript phrase. The model returns
+// the phrase index and the quote, channel and timestamps are copied from the transcript.
+type scorecardEvidence struct {
+	Phrase   int     `json:"phrase"`
+	Quote    string  `json:"quote"`
+	Channel  int     `json:"channel"`
+	StartSec float64 `json:"start_sec"`
+	EndSec   float64 `json:"end_sec"`
+}
+
+// questionKey is the property name of the i-th question (zero based) in the response schema.
+func questionKey(i int) string {
+	return fmt.Sprintf("q%d", i+1)
+}
+
+// buildScorecardSchema describes the expected answer for every form question:
+// an enum of the option scores for question_option and a min/max range for question_score.
+// Optional questions additionally accept null. With explain every answer also carries
+// a rationale and evidence quotes referencing transcript phrases by index.
+func buildScorecardSchema(form *model.ScorecardForm, explain bool) *llm.ResponseSchema {
+	answers := make(map[string]any, len(form.Questions))
+	keys := make([]string, 0, len(form.Questions))
+	for i, q := range form.Questions {
+		score := map[string]any{
+			"type":        "integer",
+			"description": q.Question,
+		}
+		switch q.Type {
+		case questionTypeOption:
+			values := make([]any, 0, len(q.Options)+1)
+			for _, opt := range q.Options {
+				values = append(values, opt.Score)
+			}
+			if !q.Required {
+				values = append(values, nil)
+			}
+			score["enum"] = values
+		case questionTypeScore:
+			score["minimum"] = q.Min
+			score["maximum"] = q.Max
+		}
+		if !q.Required {
+			score["type"] = []string{"integer", "null"}
+		}
+
+		properties := map[string]any{"score": score}
+		required := []string{"score"}
+		if explain {
+			properties["rationale"] = map[string]any{"type": "string"}
+			properties["evidence"] = map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"phrase": map[string]any{"type": "integer", "description": "index of the transcript phrase"},
+						"quote":  map[string]any{"type": "string", "description": "verbatim part of the phrase"},
+					},
+					"required":             []string{"phrase", "quote"},
+					"additionalProperties": false,
+				},
+			}
+			required = append(required, "rationale", "evidence")
+		}
+
+		key := questionKey(i)
+		keys = append(keys, key)
+		answers[key] = map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	}
+
+	return &llm.ResponseSchema{
+		Name:   "scorecard_answers",
+		Strict: true,
+		Schema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"answers": map[string]any{
+					"type":                 "object",
+					"properties":           answers,
+					"required":             keys,
+					"additionalProperties": false,
+				},
+				"comment": map[string]any{"type": "string"},
+			},
+			"required":             []string{"answers", "comment"},
+			"additionalProperties": false,
+		},
+	}
+}
+
+// parseScorecardResult decodes the structured output and validates every answer against the form
+// and, with explain, every evidence quote against the transcript.
+func parseScorecardResult(form *model.ScorecardForm, phrases []transcriptPhrase, content string, explain bool) (*scorecardResult, error) {
+	var parsed struct {
+		Answers map[string]scorecardAnswer `json:"answers"`
+		Comment string                     `json:"comment"`
+	}
+	if err := json.Unmarshal([]byte(content), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid scorecard JSON: %w", err)
+	}
+
+	result := &scorecardResult{
+		Answers: make([]*int, len(form.Questions)),
+		Comment: parsed.Comment,
+	}
+	if explain {
+		result.Explanations = make([]scorecardExplanation, len(form.Questions))
+	}
+	for i := range form.Questions {
+		answer, ok := parsed.Answers[questionKey(i)]
+		if !ok {
+			continue
+		}
+		result.Answers[i] = answer.Score
+		if explain {
+			for j, ev := range answer.Evidence {
+				if ev.Phrase >= 0 && ev.Phrase < len(phrases) {
+					p := phrases[ev.Phrase]
+					answer.Evidence[j].Channel = p.Channel
+					answer.Evidence[j].StartSec = p.StartSec
+					answer.Evidence[j].EndSec = p.EndSec
+				}
+			}
+			result.Explanations[i] = scorecardExplanation{Rationale: answer.Rationale, Evidence: answer.Evidence}
+		}
+	}
+
+	violations := validateScorecardAnswers(form, parsed.Answers)
+	if explain {
+		violations = errors.Join(violations, validateScorecardEvidence(phrases, parsed.Answers))
+	}
+	if violations != nil {
+		return result, errors.New(strings.ReplaceAll(violations.Error(), "\n", "; "))
+	}
+	return result, nil
+}
+
+// validateScorecardEvidence checks that every evidence quote references an existing phrase
+// and is a verbatim part of its text.
+func validateScorecardEvidence(phrases []transcriptPhrase, answers map[string]scorecardAnswer) error {
+	var violations []string
+	for key, answer := range answers {
+		if answer.Score != nil && strings.TrimSpace(answer.Rationale) == "" {
+			violations = append(violations, fmt.Sprintf("%s: rationale is required", key))
+		}
+		for _, ev := range answer.Evidence {
+			if ev.Phrase < 0 || ev.Phrase >= len(phrases) {
+				violations = append(violations, fmt.Sprintf("%s: phrase %d does not exist", key, ev.Phrase))
+				continue
+			}
+			if !containsQuote(phrases[ev.Phrase].Text, ev.Quote) {
+				violations = append(violations, fmt.Sprintf("%s: quote %q is not found in phrase %d", key, ev.Quote, ev.Phrase))
+			}
+		}
+	}
+	if len(violations) > 0 {
+		slices.Sort(violations)
+		return errors.New(strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// formatScorecardComment renders the explanations as the numbered rate comment,
+// each rationale followed by its evidence with the offset in the recording.
+func formatScorecardComment(summary string, explanations []scorecardExplanation) string {
+	var b strings.Builder
+	if s := strings.TrimSpace(summary); s != "" && s != "-" {
+		b.WriteString(s)
+		b.WriteString("\n\n")
+	}
+	for i, e := range explanations {
+		b.WriteString(fmt.Sprintf("%d. %s\n", i+1, strings.TrimSpace(e.Rationale)))
+		for _, ev := range e.Evidence {
+			b.WriteString(fmt.Sprintf("   [%s] «%s»\n", formatOffset(ev.StartSec), ev.Quote))
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// validateScorecardAnswers checks the answers against the form: the answer count matches the questions,
+// every required question is answered, option scores are one of the defined options,
+// range scores are within min/max and there are no extra answers.
+func validateScorecardAnswers(form *model.ScorecardForm, answers map[string]scorecardAnswer) error {
+	var violations []string
+	if len(answers) != len(form.Questions) {
+		violations = append(violations, fmt.Sprintf("answers: expected %d answers, got %d", len(form.Questions), len(answers)))
+	}
+	for i, q := range form.Questions {
+		key := questionKey(i)
+		answer, ok := answers[key]
+		if !ok || answer.Score == nil {
+			if q.Required {
+				violations = append(violations, fmt.Sprintf("%s: answer is required", key))
+			}
+			continue
+		}
+		score := *answer.Score
+		switch q.Type {
+		case questionTypeOption:
+			allowed := make([]int, 0, len(q.Options))
+			for _, opt := range q.Options {
+				allowed = append(allowed, opt.Score)
+			}
+			if !slices.Contains(allowed, score) {
+				violations = append(violations, fmt.Sprintf("%s: score %d is not one of %v", key, score, allowed))
+			}
+		case questionTypeScore:
+			if score < q.Min || score > q.Max {
+				violations = append(violations, fmt.Sprintf("%s: score %d is out of range %d..%d", key, score, q.Min, q.Max))
+			}
+		}
+	}
+	for key := range answers {
+		if !isQuestionKey(form, key) {
+			violations = append(violations, fmt.Sprintf("%s: unknown question", key))
+		}
+	}
+	if len(violations) > 0 {
+		slices.Sort(violations)
+		return errors.New(strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+func isQuestionKey(form *model.ScorecardForm, key string) bool {
+	for i := range form.Questions {
+		if questionKey(i) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/webitel/call_audit/internal/app/call_processor/llm"
+)
+
+// transcriptPhrase is a single recognized phrase of the call transcript.
+// Index is the position in the transcript and is referenced by scorecard evidence.
+type transcriptPhrase struct {
+	Index    int
+	Channel  int
+	Speaker  string
+	Text     string
+	StartSec float64
+	EndSec   float64
+}
+
+// parsePhrases converts the storage transcript items into phrases, naming the speakers
+// of channel 0 and 1 after the call parties when known.
+func parsePhrases(items []map[string]any, from, to string) []transcriptPhrase {
+	phrases := make([]transcriptPhrase, 0, len(items))
+	for i, p := range items {
+		text, _ := p["phrase"].(string)
+		channel, _ := p["channel"].(float64)
+		start, _ := p["start_sec"].(float64)
+		end, _ := p["end_sec"].(float64)
+
+		name := fmt.Sprintf("Спікер %d", int(channel))
+		if int(channel) == 0 && from != "" {
+			name = from
+		} else if int(channel) == 1 && to != "" {
+			name = to
+		}
+		phrases = append(phrases, transcriptPhrase{
+			Index:    i,
+			Channel:  int(channel),
+			Speaker:  name,
+			Text:     text,
+			StartSec: start,
+			EndSec:   end,
+		})
+	}
+	return phrases
+}
+
+// buildDialogue renders the phrases one per line prefixed with the phrase index, e.g. "[3] Agent: hello".
+func buildDialogue(phrases []transcriptPhrase) string {
+	var dialogue strings.Builder
+	for _, p := range phrases {
+		dialogue.WriteString(fmt.Sprintf("[%d] %s: %s\n", p.Index, p.Speaker, p.Text))
+	}
+	return dialogue.String()
+}
+
+// chunkPhrases splits the phrases into chunks of up to maxTokens of the dialogue, every chunk
+// starts with the last overlap phrases of the previous one. A phrase larger than maxTokens is a chunk of its own.
+func chunkPhrases(phrases []transcriptPhrase, maxTokens int64, overlap int) [][]transcriptPhrase {
+	var chunks [][]transcriptPhrase
+	for start := 0; start < len(phrases); {
+		end := start
+		var tokens int64
+		for end < len(phrases) {
+			line := llm.TextTokens(buildDialogue(phrases[end : end+1]))
+			if end > start && tokens+line > maxTokens {
+				break
+			}
+			tokens += line
+			end++
+		}
+		chunks = append(chunks, phrases[start:end])
+		if end == len(phrases) {
+			break
+		}
+		// the overlap never takes the whole chunk, so every chunk moves forward
+		start = max(end-overlap, start+1)
+	}
+	return chunks
+}
+
+// containsQuote reports whether the quote is a verbatim part of the phrase text,
+// ignoring case and whitespace differences.
+func containsQuote(text, quote string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Join(strings.Fields(s), " "))
+	}
+	q := normalize(quote)
+	return q != "" && strings.Contains(normalize(text), q)
+}
+
+// formatOffset formats an offset in seconds from the start of the recording as mm:ss.
+func formatOffset(sec float64) string {
+	total := int(sec)
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
@@ -0,0 +1,91 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/webitel/call_audit/model"
+)
+
+func testPhrases(n int) []transcriptPhrase {
+	phrases := make([]transcriptPhrase, n)
+	for i := range phrases {
+		// "[i] A: " and the text make a line of about 10 tokens
+		phrases[i] = transcriptPhrase{Index: i, Speaker: "A", Text: strings.Repeat("x", 33)}
+	}
+	return phrases
+}
+
+func chunkIndexes(chunks [][]transcriptPhrase) [][]int {
+	res := make([][]int, len(chunks))
+	for i, chunk := range chunks {
+		for _, p := range chunk {
+			res[i] = append(res[i], p.Index)
+		}
+	}
+	return res
+}
+
+func TestChunkPhrases(t *testing.T) {
+	tests := []struct {
+		name      string
+		phrases   []transcriptPhrase
+		maxTokens int64
+		overlap   int
+		want      [][]int
+	}{
+		{name: "fits a chunk", phrases: testPhrases(3), maxTokens: 100, overlap: 1, want: [][]int{{0, 1, 2}}},
+		{name: "without overlap", phrases: testPhrases(6), maxTokens: 30, overlap: 0, want: [][]int{{0, 1, 2}, {3, 4, 5}}},
+		{name: "with overlap", phrases: testPhrases(6), maxTokens: 30, overlap: 1, want: [][]int{{0, 1, 2}, {2, 3, 4}, {4, 5}}},
+		{name: "overlap of the whole chunk", phrases: testPhrases(4), maxTokens: 20, overlap: 5, want: [][]int{{0, 1}, {1, 2}, {2, 3}}},
+		{name: "phrase larger than a chunk", phrases: testPhrases(2), maxTokens: 5, overlap: 1, want: [][]int{{0}, {1}}},
+		{name: "no phrases", phrases: nil, maxTokens: 30, overlap: 1, want: [][]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chunkIndexes(chunkPhrases(tt.phrases, tt.maxTokens, tt.overlap))
+			if len(got) != len(tt.want) {
+				t.Fatalf("chunkPhrases() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if len(got[i]) != len(tt.want[i]) {
+					t.Fatalf("chunkPhrases() = %v, want %v", got, tt.want)
+				}
+				for j := range got[i] {
+					if got[i][j] != tt.want[i][j] {
+						t.Fatalf("chunkPhrases() = %v, want %v", got, tt.want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerChunksOverlap(t *testing.T) {
+	zero, two := 0, 2
+	tests := []struct {
+		name    string
+		overlap *int
+		want    int
+	}{
+		{name: "default when empty", overlap: nil, want: model.DefaultChunkOverlapPhrases},
+		{name: "no overlap", overlap: &zero, want: 0},
+		{name: "set", overlap: &two, want: 2},
+	}
+	phrases := testPhrases(12)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &model.CallJob{Params: model.JobParams{Chunking: &model.TranscriptChunking{
+				Strategy:       model.ChunkStrategyMapReduce,
+				MaxTokens:      40,
+				OverlapPhrases: tt.overlap,
+			}}}
+			got := chunkIndexes((&Runner{}).chunks(job, phrases))
+			want := chunkIndexes(chunkPhrases(phrases, 40, tt.want))
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("chunks() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -2,37 +2,128 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
 	pb "github.com/webitel/call_audit/api/call_audit"
+	processor "github.com/webitel/call_audit/internal/app/call_processor"
+	cerror "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
+	grpcopts "github.com/webitel/call_audit/model/options/grpc"
+	util2 "github.com/webitel/call_audit/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+const (
+	defaultPreviewSampleSize = 10
+	maxPreviewSampleSize     = 100
+	// every audited call costs an LLM request and waits for its transcript
+	maxPreviewRuns = 5
+)
+
+// previewRuleFields are the generation settings of the previewed rule applied by applyRuleOverrides.
+var previewRuleFields = []string{
+	"scorecard", "default_promt", "save_explanation", "variable", "model",
+	"temperature", "top_p", "max_output_tokens", "seed", "reasoning_effort",
+}
+
+var CallQuestionnaireRuleMetadata = model.NewObjectMetadata("", "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "domain_id", Default: false},
+	{Name: "created_at", Default: true},
+	{Name: "created_by", Default: true},
+	{Name: "updated_at", Default: true},
+	{Name: "updated_by", Default: true},
+	{Name: "name", Default: true},
+	{Name: "language_profile", Default: true},
+	{Name: "description", Default: true},
+	{Name: "enabled", Default: true},
+	{Name: "cognitive_profile", Default: true},
+	{Name: "from", Default: true},
+	{Name: "to", Default: true},
+	{Name: "call_direction", Default: true},
+	{Name: "min_call_duration", Default: true},
+	{Name: "variable", Default: true},
+	{Name: "default_promt", Default: true},
+	{Name: "save_explanation", Default: true},
+	{Name: "last_stored_at", Default: true},
+	{Name: "model", Default: true},
+	{Name: "temperature", Default: true},
+	{Name: "top_p", Default: true},
+	{Name: "max_output_tokens", Default: true},
+	{Name: "seed", Default: true},
+	{Name: "reasoning_effort", Default: true},
+	{Name: "scorecard", Default: true},
+	{Name: "ver", Default: false},
+	{Name: "etag", Default: true},
+	{Name: "call_filter", Default: true},
+	{Name: "sample_percent", Default: true},
+	{Name: "agent_daily_limit", Default: true},
+	{Name: "agent_weekly_limit", Default: true},
+	{Name: "daily_limit", Default: true},
+	{Name: "schedule", Default: true},
+	{Name: "priority", Default: true},
+	{Name: "paused_reason", Default: true},
+	{Name: "chunking", Default: true},
+})
+
 type CallQuestionnaireRuleService struct {
 	app *App
+	// previews audits the calls of PreviewRule, the store only accounts their LLM usage
+	previews *processor.Runner
 	pb.UnimplementedCallQuestionnaireRuleServiceServer
 }
 
 func NewCallQuestionnaireRuleService(app *App) (*CallQuestionnaireRuleService, error) {
 	service := &CallQuestionnaireRuleService{
-		app: app,
+		app:      app,
+		previews: processor.NewRunner(processor.LoadConfig(), app.Store.ServiceStore(), nil),
 	}
 	return service, nil
 }
 
-func (s *CallQuestionnaireRuleService) List(ctx context.Context, req *pb.Empty) (*pb.CallQuestionnaireRuleList, error) {
-	lp, err := s.app.Store.CallQuestionnaireRules().List(ctx)
+func (s *CallQuestionnaireRuleService) List(ctx context.Context, req *pb.ListCallQuestionnaireRulesRequest) (*pb.CallQuestionnaireRuleList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithSearch(req),
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, CallQuestionnaireRuleMetadata, util2.ParseFieldsForEtag),
+		grpcopts.WithSort(req),
+		grpcopts.WithFilters(req),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.list.invalid_args", err.Error())
+	}
+
+	list, err := s.app.Store.CallQuestionnaireRules().List(searchOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list call questionnaire rules: %w", err)
 	}
-	return lp, nil
+	list.Items, list.Next = util.ResolvePaging(searchOpts.GetSize(), list.Items)
+	return list, nil
 }
 
 func (s *CallQuestionnaireRuleService) Create(ctx context.Context, req *pb.UpsertCallQuestionnaireRuleRequest) (*pb.CallQuestionnaireRule, error) {
 	ruleReq := req.GetRule()
 	if ruleReq == nil {
-		return nil, fmt.Errorf("request does not contain CallQuestionnaireRule")
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.create.rule_required", "request does not contain CallQuestionnaireRule")
 	}
-	rule, err := s.app.Store.CallQuestionnaireRules().Create(ctx, ruleReq)
+	if err := validateCallQuestionnaireRule(ruleReq); err != nil {
+		return nil, err
+	}
+
+	createOpts, err := grpcopts.NewCreateOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.create.invalid_args", err.Error())
+	}
+	createOpts.Fields = util2.ParseFieldsForEtag(CallQuestionnaireRuleMetadata.GetDefaultFields())
+
+	rule, err := s.app.Store.CallQuestionnaireRules().Create(createOpts, ruleReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create call questionnaire rule: %w", err)
 	}
@@ -42,27 +133,404 @@ func (s *CallQuestionnaireRuleService) Create(ctx context.Context, req *pb.Upser
 func (s *CallQuestionnaireRuleService) Update(ctx context.Context, req *pb.UpsertCallQuestionnaireRuleRequest) (*pb.CallQuestionnaireRule, error) {
 	ruleReq := req.GetRule()
 	if ruleReq == nil {
-		return nil, fmt.Errorf("request does not contain CallQuestionnaireRule")
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.update.rule_required", "request does not contain CallQuestionnaireRule")
+	}
+	if err := resolveRuleEtag(ruleReq, "update"); err != nil {
+		return nil, err
+	}
+	if ruleReq.GetId() == 0 {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.update.id_required", "id is required")
 	}
-	rule, err := s.app.Store.CallQuestionnaireRules().Update(ctx, ruleReq)
+	if err := validateCallQuestionnaireRule(ruleReq); err != nil {
+		return nil, err
+	}
+
+	updateOpts, err := grpcopts.NewUpdateOptions(ctx, grpcopts.WithUpdateIDs([]int64{int64(ruleReq.GetId())}))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.update.invalid_args", err.Error())
+	}
+	updateOpts.Fields = util2.ParseFieldsForEtag(CallQuestionnaireRuleMetadata.GetDefaultFields())
+
+	rule, err := s.app.Store.CallQuestionnaireRules().Update(updateOpts, ruleReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update call questionnaire rule: %w", err)
+		return nil, ruleStoreError("update", ruleReq.GetId(), err)
+	}
+	return rule, nil
+}
+
+func (s *CallQuestionnaireRuleService) Patch(ctx context.Context, req *pb.PatchCallQuestionnaireRuleRequest) (*pb.CallQuestionnaireRule, error) {
+	ruleReq := req.GetRule()
+	if ruleReq == nil {
+		ruleReq = &pb.CallQuestionnaireRule{}
+	}
+	if req.GetId() != 0 {
+		ruleReq.Id = req.GetId()
+	}
+	if err := resolveRuleEtag(ruleReq, "patch"); err != nil {
+		return nil, err
+	}
+	if ruleReq.GetId() == 0 {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.patch.id_required", "id is required")
+	}
+
+	updateOpts, err := grpcopts.NewUpdateOptions(
+		ctx,
+		grpcopts.WithUpdateFields(req, CallQuestionnaireRuleMetadata),
+		grpcopts.WithUpdateMasker(req),
+		grpcopts.WithUpdateIDs([]int64{int64(ruleReq.GetId())}),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.patch.invalid_args", err.Error())
+	}
+	// the caller needs the new etag for the next change whatever fields it has requested
+	updateOpts.Fields = util2.EnsureIdAndVerField(updateOpts.Fields)
+	if err := validateCallQuestionnaireRule(ruleReq, updateOpts.GetMask()...); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.app.Store.CallQuestionnaireRules().Update(updateOpts, ruleReq)
+	if err != nil {
+		return nil, ruleStoreError("patch", ruleReq.GetId(), err)
 	}
 	return rule, nil
 }
 
 func (s *CallQuestionnaireRuleService) Delete(ctx context.Context, req *pb.DeleteCallQuestionnaireRuleRequest) (*pb.CallQuestionnaireRule, error) {
-	err := s.app.Store.CallQuestionnaireRules().Delete(ctx, int64(req.GetId()))
+	id := req.GetId()
+	var ver *int32
+	if tag := req.GetEtag(); tag != "" {
+		etagID, etagVer, err := util2.DecodeEtag(util2.EtagCallQuestionnaireRule, tag)
+		if err != nil || (id != 0 && int64(id) != etagID) {
+			return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.delete.invalid_etag", "etag does not match the rule")
+		}
+		id, ver = int32(etagID), &etagVer
+	}
+	deleteOpts, err := grpcopts.NewDeleteOptions(ctx, grpcopts.WithDeleteID(int64(id)))
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete call questionnaire rule: %w", err)
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.delete.invalid_args", err.Error())
+	}
+	if ver != nil {
+		deleteOpts.AddFilter("ver", *ver)
 	}
-	return &pb.CallQuestionnaireRule{Id: req.Id}, nil
+
+	err = s.app.Store.CallQuestionnaireRules().Delete(deleteOpts)
+	if err != nil {
+		return nil, ruleStoreError("delete", id, err)
+	}
+	return &pb.CallQuestionnaireRule{Id: id}, nil
 }
 
 func (s *CallQuestionnaireRuleService) Get(ctx context.Context, req *pb.GetCallQuestionnaireRuleRequest) (*pb.CallQuestionnaireRule, error) {
-	rule, err := s.app.Store.CallQuestionnaireRules().Get(ctx, int64(req.Id))
+	searchOpts, err := grpcopts.NewLocateOptions(ctx, grpcopts.WithID(int64(req.GetId())))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get call questionnaire rule: %w", err)
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.get.invalid_args", err.Error())
+	}
+	searchOpts.Fields = util2.ParseFieldsForEtag(CallQuestionnaireRuleMetadata.GetDefaultFields())
+
+	rule, err := s.app.Store.CallQuestionnaireRules().Get(searchOpts)
+	if err != nil {
+		return nil, ruleStoreError("get", req.GetId(), err)
 	}
 	return rule, nil
 }
+
+// PreviewRule lists the calls an unsaved rule would audit first and audits the first run of them
+// without ordering transcripts, writing the variables, posting the ratings or storing the results.
+func (s *CallQuestionnaireRuleService) PreviewRule(ctx context.Context, req *pb.PreviewCallQuestionnaireRuleRequest) (*pb.PreviewCallQuestionnaireRuleResponse, error) {
+	ruleReq := req.GetRule()
+	if ruleReq == nil {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.preview.rule_required", "request does not contain CallQuestionnaireRule")
+	}
+	if err := validateCallQuestionnaireRule(ruleReq, "language_profile", "cognitive_profile", "from"); err != nil {
+		return nil, err
+	}
+	sampleSize := req.GetSampleSize()
+	if sampleSize == 0 {
+		sampleSize = defaultPreviewSampleSize
+	}
+	switch {
+	case sampleSize < 0 || sampleSize > maxPreviewSampleSize:
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.preview.invalid_sample_size", fmt.Sprintf("sample_size must be between 1 and %d", maxPreviewSampleSize))
+	case req.GetRun() < 0 || req.GetRun() > maxPreviewRuns:
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.preview.invalid_run", fmt.Sprintf("run must be between 0 and %d", maxPreviewRuns))
+	}
+	searchOpts, err := grpcopts.NewSearchOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.call_questionnaire_rule.preview.invalid_args", err.Error())
+	}
+
+	minCallDuration := ruleReq.GetMinCallDuration()
+	rule := &model.CallQuestionnaireRule{
+		Id:               int(ruleReq.GetId()),
+		DomainId:         int(searchOpts.GetAuthOpts().GetDomainId()),
+		CallDirection:    ruleReq.GetCallDirection(),
+		LanguageProfile:  int(ruleReq.GetLanguageProfile().GetId()),
+		CognitiveProfile: int(ruleReq.GetCognitiveProfile().GetId()),
+		From:             ruleReq.GetFrom().AsTime(),
+		Last:             ruleReq.GetFrom().AsTime(),
+		MinCallDuration:  &minCallDuration,
+		CallFilter:       callFilterFromProto(ruleReq.GetCallFilter()),
+		Chunking:         chunkingFromProto(ruleReq.GetChunking()),
+	}
+	if v := ruleReq.GetSamplePercent(); v != nil {
+		rule.SamplePercent = &v.Value
+	}
+	if v := ruleReq.GetLastStoredAt(); v != nil {
+		rule.Last = v.AsTime()
+	}
+	if err := applyRuleOverrides(rule, ruleReq, previewRuleFields); err != nil {
+		return nil, err
+	}
+	found, err := resolveRuleProfiles(s.app, rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule profiles: %w", err)
+	}
+	if !found {
+		return nil, cerror.NewNotFoundError("app.call_questionnaire_rule.preview.cognitive_profile_not_found", fmt.Sprintf("cognitive profile %d not found", rule.CognitiveProfile))
+	}
+
+	if req.GetRun() > 0 {
+		if err := s.checkPreviewBudget(ctx, rule); err != nil {
+			return nil, err
+		}
+	}
+
+	count, calls, err := previewCalls(s.app, rule, int(sampleSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview rule calls: %w", err)
+	}
+	res := &pb.PreviewCallQuestionnaireRuleResponse{Count: count, Items: make([]*pb.PreviewCall, len(calls))}
+	for i, call := range calls {
+		res.Items[i] = &pb.PreviewCall{
+			CallId:    call.CallID,
+			StoredAt:  timestamppb.New(call.StoredAt),
+			Direction: call.Direction,
+			TalkSec:   call.TalkSec,
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := range min(int(req.GetRun()), len(calls)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job := &model.CallJob{RuleID: int64(rule.Id), Params: calls[i].Params}
+			result, err := s.previews.Preview(ctx, job)
+			if err != nil {
+				res.Items[i].Error = err.Error()
+				return
+			}
+			res.Items[i].Result = previewAuditResult(job, result)
+		}()
+	}
+	wg.Wait()
+	return res, nil
+}
+
+// checkPreviewBudget refuses the LLM requests of a preview once the domain has spent its monthly budget
+// or the saved rule being previewed is paused.
+func (s *CallQuestionnaireRuleService) checkPreviewBudget(ctx context.Context, rule *model.CallQuestionnaireRule) error {
+	spent, err := domainBudgetSpent(ctx, s.app, int64(rule.DomainId))
+	if err != nil {
+		return fmt.Errorf("failed to get the domain budget: %w", err)
+	}
+	if spent {
+		return cerror.NewConflictError("app.call_questionnaire_rule.preview.budget_spent", budgetExhausted)
+	}
+	if rule.Id == 0 {
+		return nil
+	}
+	saved, err := getRule(s.app, int64(rule.DomainId), int64(rule.Id))
+	if err != nil {
+		return fmt.Errorf("failed to get rule: %w", err)
+	}
+	if saved != nil && saved.PausedReason != "" {
+		return cerror.NewConflictError("app.call_questionnaire_rule.preview.rule_paused", fmt.Sprintf("call questionnaire rule %d is paused: %s", rule.Id, saved.PausedReason))
+	}
+	return nil
+}
+
+// previewAuditResult converts the output of a previewed job, it has no id, job or rate.
+func previewAuditResult(job *model.CallJob, res *processor.AuditResult) *pb.AuditResult {
+	out := &pb.AuditResult{
+		DomainId:      job.Params.DomainID,
+		CallId:        job.Params.CallID,
+		ScorecardId:   int32(job.Params.Scorecard),
+		Provider:      res.Provider,
+		Model:         res.Model,
+		PromptVersion: res.PromptVersion,
+		RawResponse:   res.RawResponse,
+		Summary:       res.Summary,
+		Category:      res.Category,
+		Usage: &pb.AuditUsage{
+			PromptTokens:     res.Usage.PromptTokens,
+			CompletionTokens: res.Usage.CompletionTokens,
+			TotalTokens:      res.Usage.PromptTokens + res.Usage.CompletionTokens,
+		},
+		LatencyMs: res.Latency.Milliseconds(),
+	}
+	// the total sums the answered questions, it stays null when none is answered
+	for i, score := range res.Scores {
+		item := &pb.AuditScore{Question: int32(i)}
+		if score != nil {
+			item.Score = wrapperspb.Int32(int32(*score))
+			out.Score = wrapperspb.Int32(out.GetScore().GetValue() + int32(*score))
+		}
+		out.Scores = append(out.Scores, item)
+	}
+	return out
+}
+
+// callFilterFromProto converts the call filter of a rule, nil stays nil.
+func callFilterFromProto(filter *pb.CallFilter) *model.CallFilter {
+	if filter == nil {
+		return nil
+	}
+	return &model.CallFilter{
+		Queues:       filter.GetQueueId(),
+		Teams:        filter.GetTeamId(),
+		Agents:       filter.GetAgentId(),
+		Gateways:     filter.GetGatewayId(),
+		HangupCauses: filter.GetHangupCause(),
+		Tags:         filter.GetTags(),
+		Destination:  filter.GetDestination(),
+		Number:       filter.GetNumber(),
+		Variables:    filter.GetVariables(),
+		MinDuration:  filter.GetMinDuration(),
+		MaxDuration:  filter.GetMaxDuration(),
+		TimeWindows:  callTimeWindowsFromProto(filter.GetTimeWindows()),
+	}
+}
+
+func callTimeWindowsFromProto(windows []*pb.CallTimeWindow) []model.CallTimeWindow {
+	if len(windows) == 0 {
+		return nil
+	}
+	res := make([]model.CallTimeWindow, 0, len(windows))
+	for _, w := range windows {
+		res = append(res, model.CallTimeWindow{
+			Days:        w.GetDays(),
+			StartMinute: w.GetStartMinute(),
+			EndMinute:   w.GetEndMinute(),
+		})
+	}
+	return res
+}
+
+// chunkingFromProto converts the transcript chunking of a rule, nil stays nil.
+func chunkingFromProto(chunking *pb.TranscriptChunking) *model.TranscriptChunking {
+	if chunking == nil {
+		return nil
+	}
+	strategy := model.ChunkStrategyMapReduce
+	if chunking.GetStrategy() == pb.ChunkStrategy_CHUNK_STRATEGY_NONE {
+		strategy = model.ChunkStrategyNone
+	}
+	res := &model.TranscriptChunking{
+		Strategy:  strategy,
+		MaxTokens: int(chunking.GetMaxTokens()),
+	}
+	if v := chunking.GetOverlapPhrases(); v != nil {
+		overlap := int(v.GetValue())
+		res.OverlapPhrases = &overlap
+	}
+	return res
+}
+
+// validateCallQuestionnaireRule checks the fields required by the call_questionnaire_rule table.
+// With a mask only the masked fields are checked.
+func validateCallQuestionnaireRule(rule *pb.CallQuestionnaireRule, mask ...string) error {
+	checked := func(field string) bool {
+		return len(mask) == 0 || slices.Contains(mask, field)
+	}
+	switch {
+	case checked("name") && rule.GetName() == "":
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.name_required", "name is required")
+	case checked("language_profile") && rule.GetLanguageProfile().GetId() == 0:
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.language_profile_required", "language_profile is required")
+	case checked("cognitive_profile") && rule.GetCognitiveProfile().GetId() == 0:
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.cognitive_profile_required", "cognitive_profile is required")
+	case checked("from") && rule.GetFrom() == nil:
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.from_required", "from is required")
+	case checked("sample_percent") && rule.GetSamplePercent() != nil && (rule.GetSamplePercent().GetValue() < 1 || rule.GetSamplePercent().GetValue() > 100):
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_sample_percent", "sample_percent must be between 1 and 100")
+	case checked("agent_daily_limit") && rule.GetAgentDailyLimit() != nil && rule.GetAgentDailyLimit().GetValue() < 1,
+		checked("agent_weekly_limit") && rule.GetAgentWeeklyLimit() != nil && rule.GetAgentWeeklyLimit().GetValue() < 1,
+		checked("daily_limit") && rule.GetDailyLimit() != nil && rule.GetDailyLimit().GetValue() < 1:
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_limit", "agent_daily_limit, agent_weekly_limit and daily_limit must be positive")
+	case checked("call_filter") && !validCallFilterDuration(rule.GetCallFilter()):
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_call_filter", "call_filter min_duration and max_duration must not be negative, min_duration must not exceed max_duration")
+	case checked("call_filter") && !validCallTimeWindows(rule.GetCallFilter().GetTimeWindows()):
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_time_windows", "call_filter time_windows days must be between 1 and 7, start_minute must be before end_minute within 0 and 1440")
+	case checked("chunking") && !validChunking(rule.GetChunking()):
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_chunking", fmt.Sprintf("chunking max_tokens must be at least %d, overlap_phrases between 0 and %d", minChunkTokens, maxChunkOverlapPhrases))
+	// an empty priority of 0 is stored as the default
+	case checked("priority") && (rule.GetPriority() < 0 || rule.GetPriority() > model.RulePriorityMax):
+		return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_priority", fmt.Sprintf("priority must be between 1 and %d, or 0 for the default of %d", model.RulePriorityMax, model.RulePriorityDefault))
+	case checked("schedule") && rule.GetSchedule() != "":
+		if _, err := util2.ParseSchedule(rule.GetSchedule()); err != nil {
+			return cerror.NewBadRequestError("app.call_questionnaire_rule.validate.invalid_schedule", fmt.Sprintf("invalid schedule: %s", err))
+		}
+	}
+	return nil
+}
+
+// validCallFilterDuration checks the duration range of the filter, 0 is no limit.
+func validCallFilterDuration(filter *pb.CallFilter) bool {
+	minDuration, maxDuration := filter.GetMinDuration(), filter.GetMaxDuration()
+	return minDuration >= 0 && maxDuration >= 0 && (maxDuration == 0 || minDuration <= maxDuration)
+}
+
+// Bounds of the transcript chunking of a rule, a smaller chunk loses the context of the call.
+const (
+	minChunkTokens         = 1000
+	maxChunkOverlapPhrases = 50
+)
+
+// validChunking checks the size and the overlap of the chunks, a zero size and an empty overlap are the defaults.
+func validChunking(chunking *pb.TranscriptChunking) bool {
+	maxTokens, overlap := chunking.GetMaxTokens(), chunking.GetOverlapPhrases().GetValue()
+	return (maxTokens == 0 || maxTokens >= minChunkTokens) && overlap >= 0 && overlap <= maxChunkOverlapPhrases
+}
+
+// validCallTimeWindows checks the days and the minutes of the windows, a window does not cross midnight.
+func validCallTimeWindows(windows []*pb.CallTimeWindow) bool {
+	for _, w := range windows {
+		for _, day := range w.GetDays() {
+			if day < 1 || day > 7 {
+				return false
+			}
+		}
+		if w.GetStartMinute() < 0 || w.GetEndMinute() > 24*60 || w.GetStartMinute() >= w.GetEndMinute() {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveRuleEtag takes the id and the expected version from the rule etag,
+// an etag of another rule than the requested id is rejected.
+func resolveRuleEtag(rule *pb.CallQuestionnaireRule, action string) error {
+	if rule.GetEtag() == "" {
+		return nil
+	}
+	id, ver, err := util2.DecodeEtag(util2.EtagCallQuestionnaireRule, rule.GetEtag())
+	if err != nil || (rule.GetId() != 0 && int64(rule.GetId()) != id) {
+		return cerror.NewBadRequestError("app.call_questionnaire_rule."+action+".invalid_etag", "etag does not match the rule")
+	}
+	rule.Id, rule.Ver = int32(id), ver
+	return nil
+}
+
+// ruleStoreError reports a missing rule as not found, a stale etag as conflict and wraps any other store error.
+func ruleStoreError(action string, id int32, err error) error {
+	var noRows *cerror.DBNoRowsError
+	if errors.As(err, &noRows) {
+		return cerror.NewNotFoundError("app.call_questionnaire_rule."+action+".not_found", fmt.Sprintf("call questionnaire rule %d not found", id))
+	}
+	var conflict *cerror.DBConflictError
+	if errors.As(err, &conflict) {
+		return cerror.NewConflictError("app.call_questionnaire_rule."+action+".conflict", fmt.Sprintf("call questionnaire rule %d has been changed, reload it and try again", id))
+	}
+	return fmt.Errorf("failed to %s call questionnaire rule: %w", action, err)
+}
@@ -0,0 +1,257 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/webitel/call_audit/api/call_audit"
+	cerror "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
+	grpcopts "github.com/webitel/call_audit/model/options/grpc"
+	"github.com/webitel/call_audit/model/options/grpc/shared"
+)
+
+// maxAuditCalls bounds the number of calls of a single AuditCalls request.
+const maxAuditCalls = 1000
+
+var JobMetadata = model.NewObjectMetadata("", "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "rule", Default: true},
+	{Name: "call_id", Default: true},
+	{Name: "state", Default: true},
+	{Name: "attempts", Default: true},
+	{Name: "error", Default: true},
+	{Name: "next_run_at", Default: true},
+	{Name: "created_at", Default: true},
+	{Name: "updated_at", Default: true},
+	{Name: "locked_by", Default: false},
+	{Name: "lease_until", Default: false},
+})
+
+type JobService struct {
+	app *App
+	pb.UnimplementedJobServiceServer
+}
+
+func NewJobService(app *App) (*JobService, error) {
+	return &JobService{app: app}, nil
+}
+
+func (s *JobService) Search(ctx context.Context, req *pb.SearchJobsRequest) (*pb.JobList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, JobMetadata),
+		grpcopts.WithSort(req),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.job.search.invalid_args", err.Error())
+	}
+
+	if v := req.GetCallId(); v != "" {
+		searchOpts.AddFilter("call_id", v)
+	}
+	if v := req.GetRuleId(); len(v) > 0 {
+		searchOpts.AddFilter("rule_id", v)
+	}
+	if v := req.GetState(); len(v) > 0 {
+		states := make([]model.JobState, 0, len(v))
+		for _, state := range v {
+			states = append(states, model.JobState(state))
+		}
+		searchOpts.AddFilter("state", states)
+	}
+	if v := req.GetCreatedFrom(); v != nil {
+		searchOpts.AddFilter("created_from", v.AsTime())
+	}
+	if v := req.GetCreatedTo(); v != nil {
+		searchOpts.AddFilter("created_to", v.AsTime())
+	}
+
+	items, err := s.app.Store.Jobs().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search jobs: %w", err)
+	}
+	items, next := util.ResolvePaging(searchOpts.GetSize(), items)
+
+	return &pb.JobList{
+		Items: items,
+		Page:  int32(searchOpts.GetPage()),
+		Next:  next,
+	}, nil
+}
+
+func (s *JobService) Get(ctx context.Context, req *pb.GetJobRequest) (*pb.Job, error) {
+	return s.get(ctx, "get", req.GetId(), req)
+}
+
+// Retry runs a failed, dead, cancelled or scheduled job again from the first attempt.
+func (s *JobService) Retry(ctx context.Context, req *pb.RetryJobRequest) (*pb.Job, error) {
+	updateOpts, err := grpcopts.NewUpdateOptions(ctx, grpcopts.WithUpdateIDs([]int64{req.GetId()}))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.job.retry.invalid_args", err.Error())
+	}
+	if err := s.app.Store.Jobs().Retry(updateOpts); err != nil {
+		return nil, jobStoreError("retry", req.GetId(), err)
+	}
+	return s.get(ctx, "retry", req.GetId(), req)
+}
+
+// Cancel stops a pending, running or scheduled job.
+func (s *JobService) Cancel(ctx context.Context, req *pb.CancelJobRequest) (*pb.Job, error) {
+	updateOpts, err := grpcopts.NewUpdateOptions(ctx, grpcopts.WithUpdateIDs([]int64{req.GetId()}))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.job.cancel.invalid_args", err.Error())
+	}
+	if err := s.app.Store.Jobs().Cancel(updateOpts); err != nil {
+		return nil, jobStoreError("cancel", req.GetId(), err)
+	}
+	return s.get(ctx, "cancel", req.GetId(), req)
+}
+
+// RetryFailed runs all failed and dead jobs of the rule again.
+func (s *JobService) RetryFailed(ctx context.Context, req *pb.RetryFailedJobsRequest) (*pb.RetryFailedJobsResponse, error) {
+	if req.GetRuleId() == 0 {
+		return nil, cerror.NewBadRequestError("app.job.retry_failed.rule_id_required", "rule_id is required")
+	}
+	updateOpts, err := grpcopts.NewUpdateOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.job.retry_failed.invalid_args", err.Error())
+	}
+	count, err := s.app.Store.Jobs().RetryFailed(updateOpts, req.GetRuleId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retry failed jobs: %w", err)
+	}
+	return &pb.RetryFailedJobsResponse{Count: count}, nil
+}
+
+// AuditCalls enqueues a manual audit job for every call, claimed before the jobs of the rule polling.
+func (s *JobService) AuditCalls(ctx context.Context, req *pb.AuditCallsRequest) (*pb.AuditCallsResponse, error) {
+	// the calls in the order of the request, a repeated call is audited once
+	callIDs := make([]string, 0, len(req.GetCallId()))
+	seen := make(map[string]bool, len(req.GetCallId()))
+	for _, callID := range req.GetCallId() {
+		if !seen[callID] {
+			seen[callID] = true
+			callIDs = append(callIDs, callID)
+		}
+	}
+	switch {
+	case req.GetRuleId() == 0:
+		return nil, cerror.NewBadRequestError("app.job.audit_calls.rule_id_required", "rule_id is required")
+	case len(callIDs) == 0 || seen[""]:
+		return nil, cerror.NewBadRequestError("app.job.audit_calls.call_id_required", "call_id is required")
+	case len(callIDs) > maxAuditCalls:
+		return nil, cerror.NewBadRequestError("app.job.audit_calls.too_many_calls", fmt.Sprintf("at most %d calls can be audited at once", maxAuditCalls))
+	}
+	createOpts, err := grpcopts.NewCreateOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.job.audit_calls.invalid_args", err.Error())
+	}
+
+	rule, err := getRule(s.app, createOpts.GetAuthOpts().GetDomainId(), req.GetRuleId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule: %w", err)
+	}
+	if rule == nil {
+		return nil, cerror.NewNotFoundError("app.job.audit_calls.rule_not_found", fmt.Sprintf("call questionnaire rule %d not found", req.GetRuleId()))
+	}
+	if rule.PausedReason != "" {
+		return nil, cerror.NewConflictError("app.job.audit_calls.rule_paused", fmt.Sprintf("call questionnaire rule %d is paused: %s", req.GetRuleId(), rule.PausedReason))
+	}
+	if err := applyRuleOverrides(rule, req.GetOverrides(), req.GetXJsonMask()); err != nil {
+		return nil, err
+	}
+
+	jobs, err := enqueueCalls(s.app, rule, callIDs, model.JobPriorityManual)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue calls: %w", err)
+	}
+	res := &pb.AuditCallsResponse{Items: make([]*pb.AuditCallJob, 0, len(callIDs))}
+	for _, callID := range callIDs {
+		res.Items = append(res.Items, &pb.AuditCallJob{CallId: callID, JobId: jobs[callID]})
+	}
+	return res, nil
+}
+
+// applyRuleOverrides replaces the generation settings of the rule by the masked fields of overrides.
+func applyRuleOverrides(rule *model.CallQuestionnaireRule, overrides *pb.CallQuestionnaireRule, mask []string) error {
+	if overrides == nil {
+		return nil
+	}
+	for _, field := range mask {
+		switch field {
+		case "scorecard":
+			rule.Scorecard = int32(overrides.GetScorecard().GetId())
+		case "default_promt":
+			rule.DefaultPrompt = util.StringPtr(overrides.GetDefaultPromt())
+		case "save_explanation":
+			v := overrides.GetSaveExplanation()
+			rule.SaveExplanation = &v
+		case "variable":
+			rule.Variable = util.StringPtr(overrides.GetVariable())
+		case "model":
+			rule.Model = util.StrPtrOrNil(overrides.GetModel())
+		case "temperature":
+			rule.Temperature = nil
+			if v := overrides.GetTemperature(); v != nil {
+				rule.Temperature = &v.Value
+			}
+		case "top_p":
+			rule.TopP = nil
+			if v := overrides.GetTopP(); v != nil {
+				rule.TopP = &v.Value
+			}
+		case "max_output_tokens":
+			rule.MaxOutputTokens = nil
+			if v := overrides.GetMaxOutputTokens(); v != nil {
+				rule.MaxOutputTokens = &v.Value
+			}
+		case "seed":
+			rule.Seed = nil
+			if v := overrides.GetSeed(); v != nil {
+				rule.Seed = &v.Value
+			}
+		case "reasoning_effort":
+			rule.ReasoningEffort = util.StrPtrOrNil(overrides.GetReasoningEffort())
+		default:
+			return cerror.NewBadRequestError("app.job.audit_calls.invalid_override", fmt.Sprintf("field %s can not be overridden", field))
+		}
+	}
+	return nil
+}
+
+func (s *JobService) get(ctx context.Context, action string, id int64, req shared.Fielder) (*pb.Job, error) {
+	searchOpts, err := grpcopts.NewLocateOptions(
+		ctx,
+		grpcopts.WithID(id),
+		grpcopts.WithFields(req, JobMetadata),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.job."+action+".invalid_args", err.Error())
+	}
+
+	items, err := s.app.Store.Jobs().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	if len(items) == 0 {
+		return nil, cerror.NewNotFoundError("app.job."+action+".not_found", fmt.Sprintf("job %d not found", id))
+	}
+	return items[0], nil
+}
+
+func jobStoreError(action string, id int64, err error) error {
+	var noRows *cerror.DBNoRowsError
+	if errors.As(err, &noRows) {
+		return cerror.NewNotFoundError("app.job."+action+".not_found", fmt.Sprintf("job %d not found", id))
+	}
+	var conflict *cerror.DBConflictError
+	if errors.As(err, &conflict) {
+		return cerror.NewConflictError("app.job."+action+".conflict", fmt.Sprintf("job %d state does not allow to %s it", id, action))
+	}
+	return fmt.Errorf("failed to %s job: %w", action, err)
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	pb "github.com/webitel/call_audit/api/call_audit"
+	cerror "github.com/webitel/call_audit/internal/errors"
+	grpcopts "github.com/webitel/call_audit/model/options/grpc"
+	util2 "github.com/webitel/call_audit/util"
 )
 
 type LanguageProfilesService struct {
@@ -12,7 +15,7 @@ type LanguageProfilesService struct {
 	pb.UnimplementedLanguageProfileServiceServer
 }
 
-func (s *LanguageProfilesService) CreateLanguageProfile(ctx context.Context, req *pb.CreateLanguageProfileRequest) (*pb.LanguageProfile, error) {
+func (s *LanguageProfilesService) Create(ctx context.Context, req *pb.CreateLanguageProfileRequest) (*pb.LanguageProfile, error) {
 	lp, err := s.app.Store.LanguageProfiles().Create(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create language profile: %w", err)
@@ -20,38 +23,115 @@ func (s *LanguageProfilesService) CreateLanguageProfile(ctx context.Context, req
 	return lp, nil
 }
 
-func (s *LanguageProfilesService) GetLanguageProfile(ctx context.Context, req *pb.GetLanguageProfileRequest) (*pb.LanguageProfile, error) {
-	lp, err := s.app.Store.LanguageProfiles().Get(ctx, req.Id)
+func (s *LanguageProfilesService) Get(ctx context.Context, req *pb.GetLanguageProfileRequest) (*pb.LanguageProfile, error) {
+	searchOpts, err := grpcopts.NewLocateOptions(ctx, grpcopts.WithID(int64(req.GetId())))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.language_profile.get.invalid_args", err.Error())
+	}
+	lp, err := s.app.Store.LanguageProfiles().Get(searchOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get language profile: %w", err)
 	}
 	return lp, nil
 }
 
-func (s *LanguageProfilesService) UpdateLanguageProfile(ctx context.Context, req *pb.UpdateLanguageProfileRequest) (*pb.LanguageProfile, error) {
-	lp, err := s.app.Store.LanguageProfiles().Update(ctx, req)
+func (s *LanguageProfilesService) Update(ctx context.Context, req *pb.UpdateLanguageProfileRequest) (*pb.LanguageProfile, error) {
+	profile := &pb.LanguageProfile{
+		Id:    req.GetId(),
+		Name:  req.GetName(),
+		Token: req.GetToken(),
+		Type:  req.GetType(),
+		Etag:  req.GetEtag(),
+	}
+	if err := resolveLanguageProfileEtag(profile, "update"); err != nil {
+		return nil, err
+	}
+	updateOpts, err := grpcopts.NewUpdateOptions(ctx, grpcopts.WithUpdateIDs([]int64{int64(profile.GetId())}))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.language_profile.update.invalid_args", err.Error())
+	}
+	lp, err := s.app.Store.LanguageProfiles().Update(updateOpts, profile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update language profile: %w", err)
 	}
 	return lp, nil
 }
 
-func (s *LanguageProfilesService) DeleteLanguageProfile(ctx context.Context, req *pb.DeleteLanguageProfileRequest) (*pb.LanguageProfile, error) {
-	err := s.app.Store.LanguageProfiles().Delete(ctx, req.Id)
+// Patch updates only the fields listed in x_json_mask, so the token
+// does not have to be resent to rename a profile.
+func (s *LanguageProfilesService) Patch(ctx context.Context, req *pb.PatchLanguageProfileRequest) (*pb.LanguageProfile, error) {
+	profile := &pb.LanguageProfile{
+		Id:    req.GetId(),
+		Name:  req.GetName(),
+		Token: req.GetToken(),
+		Type:  req.GetType(),
+		Etag:  req.GetEtag(),
+	}
+	if err := resolveLanguageProfileEtag(profile, "patch"); err != nil {
+		return nil, err
+	}
+	if profile.GetId() == 0 {
+		return nil, cerror.NewBadRequestError("app.language_profile.patch.id_required", "id is required")
+	}
+	updateOpts, err := grpcopts.NewUpdateOptions(
+		ctx,
+		grpcopts.WithUpdateMasker(req),
+		grpcopts.WithUpdateIDs([]int64{int64(profile.GetId())}),
+	)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.language_profile.patch.invalid_args", err.Error())
+	}
+	lp, err := s.app.Store.LanguageProfiles().Update(updateOpts, profile)
 	if err != nil {
+		return nil, fmt.Errorf("failed to patch language profile: %w", err)
+	}
+	return lp, nil
+}
+
+func (s *LanguageProfilesService) Delete(ctx context.Context, req *pb.DeleteLanguageProfileRequest) (*pb.LanguageProfile, error) {
+	profile := &pb.LanguageProfile{Id: req.GetId(), Etag: req.GetEtag()}
+	if err := resolveLanguageProfileEtag(profile, "delete"); err != nil {
+		return nil, err
+	}
+	deleteOpts, err := grpcopts.NewDeleteOptions(ctx, grpcopts.WithDeleteID(int64(profile.GetId())))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.language_profile.delete.invalid_args", err.Error())
+	}
+	if profile.GetEtag() != "" {
+		deleteOpts.AddFilter("ver", profile.GetVer())
+	}
+	if err := s.app.Store.LanguageProfiles().Delete(deleteOpts); err != nil {
 		return nil, fmt.Errorf("failed to delete language profile: %w", err)
 	}
-	return &pb.LanguageProfile{Id: req.Id}, nil
+	return &pb.LanguageProfile{Id: profile.GetId()}, nil
 }
 
-func (s *LanguageProfilesService) ListLanguageProfiles(ctx context.Context, req *pb.ListLanguageProfilesRequest) (*pb.ListLanguageProfilesResponse, error) {
-	list, err := s.app.Store.LanguageProfiles().List(ctx)
+func (s *LanguageProfilesService) List(ctx context.Context, req *pb.ListLanguageProfilesRequest) (*pb.ListLanguageProfilesResponse, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.language_profile.list.invalid_args", err.Error())
+	}
+	list, err := s.app.Store.LanguageProfiles().List(searchOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list language profiles: %w", err)
 	}
 	return list, nil
 }
 
+// resolveLanguageProfileEtag takes the id and the expected version from the profile etag,
+// an etag of another profile than the requested id is rejected.
+func resolveLanguageProfileEtag(profile *pb.LanguageProfile, action string) error {
+	if profile.GetEtag() == "" {
+		return nil
+	}
+	id, ver, err := util2.DecodeEtag(util2.EtagLanguageProfile, profile.GetEtag())
+	if err != nil || (profile.GetId() != 0 && int64(profile.GetId()) != id) {
+		return cerror.NewBadRequestError("app.language_profile."+action+".invalid_etag", "etag does not match the profile")
+	}
+	profile.Id, profile.Ver = int32(id), ver
+	return nil
+}
+
 func NewLanguageProfileService(app *App) (*LanguageProfilesService, error) {
 
 	service := &LanguageProfilesService{
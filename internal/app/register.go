@@ -31,6 +31,34 @@ func RegisterServices(grpcServer *grpc.Server, appInstance *App) {
 			},
 			name: "CallQuestionnaireRule",
 		},
+		{
+			init: func(a *App) (interface{}, error) { return NewAuditResultService(a) },
+			register: func(s *grpc.Server, svc interface{}) {
+				ca.RegisterAuditResultServiceServer(s, svc.(ca.AuditResultServiceServer))
+			},
+			name: "AuditResult",
+		},
+		{
+			init: func(a *App) (interface{}, error) { return NewJobService(a) },
+			register: func(s *grpc.Server, svc interface{}) {
+				ca.RegisterJobServiceServer(s, svc.(ca.JobServiceServer))
+			},
+			name: "Job",
+		},
+		{
+			init: func(a *App) (interface{}, error) { return NewBackfillService(a) },
+			register: func(s *grpc.Server, svc interface{}) {
+				ca.RegisterBackfillServiceServer(s, svc.(ca.BackfillServiceServer))
+			},
+			name: "Backfill",
+		},
+		{
+			init: func(a *App) (interface{}, error) { return NewUsageService(a) },
+			register: func(s *grpc.Server, svc interface{}) {
+				ca.RegisterUsageServiceServer(s, svc.(ca.UsageServiceServer))
+			},
+			name: "Usage",
+		},
 	}
 
 	// Initialize and register each service
@@ -3,14 +3,20 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math/rand"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	pb "github.com/webitel/call_audit/api/call_audit"
 	processor "github.com/webitel/call_audit/internal/app/call_processor"
 	"github.com/webitel/call_audit/model"
+	"github.com/webitel/call_audit/util"
 	"github.com/webitel/storage/pool"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"net/http"
 	_ "net/http/pprof"
@@ -21,8 +27,12 @@ type Anc struct {
 }
 
 type AppJobTask struct {
-	App *processor.App
-	Job model.CallJob
+	App   *processor.App
+	Job   model.CallJob
+	app   *App
+	lease time.Duration
+	// running counts the jobs submitted to the pool and not finished yet
+	running *atomic.Int32
 }
 
 type anyT struct {
@@ -34,64 +44,159 @@ var (
 	r      = rand.New(source)
 )
 
+// alreadyProcessingDelay is how long a job waits for the other job of its rule auditing the same call.
+const alreadyProcessingDelay = 30 * time.Second
+
+// unfinishedJobStates are the states of the jobs that still run, bound to the queries as an int[].
+var unfinishedJobStates = jobStates(model.JobStatePending, model.JobStateRunning, model.JobStateRetryScheduled)
+
+// jobStates converts the states to an int[] query argument.
+func jobStates(states ...model.JobState) []int32 {
+	res := make([]int32, len(states))
+	for i, state := range states {
+		res[i] = int32(state)
+	}
+	return res
+}
+
 func (t *AppJobTask) Execute() {
-	fmt.Println(t.Job.Params.CallID, "Execute started")
-	t.App.Process(&t.Job)
-	fmt.Println(t.Job.Params.CallID, "Execute complete")
+	defer t.running.Add(-1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stop := renewJobLease(t.app, &t.Job, t.lease, cancel)
+	err := t.App.Process(ctx, &t.Job)
+	if lost := stop(); lost {
+		// another instance has claimed the job, the outcome is its to write
+		slog.Warn("Job lease lost, job aborted", slog.Int64("job_id", t.Job.ID), slog.String("uuid", t.Job.Params.CallID))
+		return
+	}
 
-	//setJobCompleted(t.App, &t.Job)
+	switch {
+	case err == nil:
+		setJobCompleted(t.app, &t.Job)
+	case errors.Is(err, processor.ErrAlreadyProcessing):
+		// the job runs again once the other job is done, it is not audited yet
+		setJobRetry(t.app, &t.Job, err, time.Now().Add(alreadyProcessingDelay))
+	default:
+		if runAt, ok := t.App.Runner.NextAttempt(&t.Job, err); ok {
+			setJobRetry(t.app, &t.Job, err, runAt)
+			return
+		}
+		setJobFailed(t.app, &t.Job, err)
+	}
+}
+
+// renewJobLease extends the lease of the job every third of its duration until the returned stop is called.
+// A lease that is not renewed because the job is no longer locked by this instance calls abort,
+// stop reports the loss.
+func renewJobLease(app *App, job *model.CallJob, lease time.Duration, abort func()) (stop func() (lost bool)) {
+	done := make(chan struct{})
+	var lost atomic.Bool
+	go func() {
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				res, err := app.Store.ServiceStore().Execute(context.Background(), `
+					UPDATE call_audit.jobs
+					SET lease_until = NOW() + $3 * INTERVAL '1 second'
+					WHERE id = $1 AND locked_by = $2
+				`, job.ID, app.instanceID, lease.Seconds())
+				if err != nil {
+					slog.Error("Failed to renew job lease", slog.Int64("job_id", job.ID), slog.String("error", err.Error()))
+					continue
+				}
+				if tag, ok := res.(interface{ RowsAffected() int64 }); ok && tag.RowsAffected() == 0 {
+					lost.Store(true)
+					abort()
+					return
+				}
+			}
+		}
+	}()
+	return func() bool {
+		close(done)
+		return lost.Load()
+	}
 }
 
+// setJobCompleted releases the lease and counts the job in its backfill, a job leased by another
+// instance in the meantime is left to it. The succeeded job is kept, the quotas and the de-duplication
+// of the rule count it.
 func setJobCompleted(app *App, job *model.CallJob) {
 	_, err := app.Store.ServiceStore().Execute(context.Background(), `
-		UPDATE call_audit.jobs
-		SET state = 3, updated_at = NOW()
-		WHERE id = $1
-	`, job.ID)
+		WITH j AS (
+			UPDATE call_audit.jobs
+			SET state = $2, locked_by = NULL, lease_until = NULL, updated_at = NOW()
+			WHERE id = $1 AND locked_by = $3
+			RETURNING backfill_id
+		)
+		UPDATE call_audit.backfills b
+		SET done = b.done + 1
+		FROM j
+		WHERE b.id = j.backfill_id
+	`, job.ID, model.JobStateSucceeded, app.instanceID)
 	if err != nil {
 		slog.Error("Failed to update job state", slog.String("error", err.Error()))
 		return
 	}
 }
 
-func dropAllJobs(app *App) {
-	_, err := app.Store.ServiceStore().Execute(context.Background(), `TRUNCATE TABLE call_audit.jobs;`)
+// setJobFailed keeps the job with the failure reason. A retryable failure without attempts left
+// makes the job dead, any other failure failed, neither is retried.
+func setJobFailed(app *App, job *model.CallJob, reason error) {
+	class := processor.ClassifyError(reason)
+	state := model.JobStateFailed
+	if class != processor.ErrorClassPermanent {
+		state = model.JobStateDead
+	}
+	_, err := app.Store.ServiceStore().Execute(context.Background(), `
+		UPDATE call_audit.jobs
+		SET state = $2, error = $3, next_run_at = NULL, locked_by = NULL, lease_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND locked_by = $4
+	`, job.ID, state, reason.Error(), app.instanceID)
 	if err != nil {
-		slog.Error("Failed to truncate jobs table", slog.String("error", err.Error()))
+		slog.Error("Failed to update job state", slog.String("error", err.Error()))
+		return
 	}
-	slog.Info("Jobs table truncated successfully")
+	slog.Warn("Job failed",
+		slog.Int64("job_id", job.ID),
+		slog.String("uuid", job.Params.CallID),
+		slog.String("state", state.String()),
+		slog.String("class", class),
+		slog.Int("attempts", job.Attempts),
+		slog.String("reason", reason.Error()))
 }
 
-func dropFinishedJobs(app *App) {
-	_, err := app.Store.ServiceStore().Execute(context.Background(), `with del as (
-		    delete from call_audit.jobs where state = 3
-		    returning *
-		), j as (
-		    select rule_id, max(call_stored_at) max_call_stored_at
-		    from del
-		    group by 1
-		)
-		update call_center.cc_call_questionnaire_rule r
-		    set last_stored_at = max_call_stored_at
-		from j
-		where r.id = j.rule_id
-	`)
+// setJobRetry schedules the next attempt of the job at runAt.
+func setJobRetry(app *App, job *model.CallJob, reason error, runAt time.Time) {
+	_, err := app.Store.ServiceStore().Execute(context.Background(), `
+		UPDATE call_audit.jobs
+		SET state = $2, error = $3, next_run_at = $4, locked_by = NULL, lease_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND locked_by = $5
+	`, job.ID, model.JobStateRetryScheduled, reason.Error(), runAt, app.instanceID)
 	if err != nil {
-		slog.Error("Failed to drop finished jobs", slog.String("error", err.Error()))
+		slog.Error("Failed to update job state", slog.String("error", err.Error()))
 		return
 	}
+	slog.Warn("Job retry scheduled",
+		slog.Int64("job_id", job.ID),
+		slog.String("uuid", job.Params.CallID),
+		slog.String("class", processor.ClassifyError(reason)),
+		slog.Int("attempts", job.Attempts),
+		slog.Time("next_run_at", runAt),
+		slog.String("reason", reason.Error()))
 }
 
-func createJobs(app *App, rule model.CallQuestionnaireRule) any {
-	fieldName := rule.Variable
-
-	query := fmt.Sprintf(`
-		INSERT INTO call_audit.jobs(rule_id, type, params)
-		SELECT
-			$1,
-			2,
+// jobParams builds the model.JobParams of a call h with the file f from the rule settings of jobParamsArgs.
+const jobParams = `
 			json_build_object(
 				'call_id', h.id,
+				'domain_id', h.domain_id,
+				'agent_id', h.agent_id,
 				'file_id', f.id,
 				'stored_at', h.stored_at,
 				'position', row_number() OVER (ORDER BY h.stored_at),
@@ -101,27 +206,23 @@ func createJobs(app *App, rule model.CallQuestionnaireRule) any {
 				'default_prompt', $5::text,
 				'save_explanation', $6::bool,
 				'variable', $7::text,
-				'scorecard', $12::int,
-				'default_prompt', $13::text
-			)
-		FROM call_center.cc_calls_history h
-		JOIN LATERAL (
-			SELECT f.id
-			FROM storage.files f
-			WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
-			LIMIT 1
-		) f ON true
-		WHERE h.domain_id = $8
-		AND h.parent_id IS NULL
-		AND h.stored_at > $9
-		AND h.payload->'%s' IS NULL
-		AND h.talk_sec > $2
-		AND ($10::varchar IS NULL OR h.direction = $10::varchar)
-		ORDER BY h.stored_at
-		LIMIT (100 - $11)
-	`, fieldName)
+				'scorecard', $8::int,
+				'provider', $9::text,
+				'provider_url', $10::text,
+				'provider_api_version', $11::text,
+				'provider_deployment', $12::text,
+				'model', $13::text,
+				'temperature', $14::float8,
+				'top_p', $15::float8,
+				'max_output_tokens', $16::int,
+				'seed', $17::int8,
+				'reasoning_effort', $18::text,
+				'chunking', $19::json
+			)`
 
-	args := []any{
+// jobParamsArgs are the $1..$19 arguments of jobParams, $1 is the rule id.
+func jobParamsArgs(rule *model.CallQuestionnaireRule) []any {
+	return []any{
 		rule.Id,
 		rule.MinCallDuration,
 		rule.LanguageProfileToken,
@@ -129,14 +230,60 @@ func createJobs(app *App, rule model.CallQuestionnaireRule) any {
 		rule.DefaultPrompt,
 		rule.SaveExplanation,
 		rule.Variable,
-		rule.DomainId,
-		rule.Last,
-		rule.CallDirection,
-		rule.Active,
 		rule.Scorecard,
-		rule.DefaultPrompt,
+		rule.Provider,
+		rule.ProviderURL,
+		rule.ProviderAPIVersion,
+		rule.ProviderDeployment,
+		rule.Model,
+		rule.Temperature,
+		rule.TopP,
+		rule.MaxOutputTokens,
+		rule.Seed,
+		rule.ReasoningEffort,
+		chunkingJSON(rule.Chunking),
 	}
+}
 
+// chunkingJSON encodes the transcript chunking of the job params, nil stays NULL.
+func chunkingJSON(chunking *model.TranscriptChunking) *string {
+	if chunking == nil {
+		return nil
+	}
+	data, err := json.Marshal(chunking)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+// createJobs enqueues the new calls of the rule, only the given call when callID is set.
+// The NOT EXISTS check skips the calls already enqueued, the unique index of the polled jobs
+// drops a call enqueued concurrently by the event consumer, the poller or another instance.
+// The calls are sampled by the sample percent of the rule, then the oldest calls are taken within
+// the daily and weekly limits of their agent and the daily limit of the rule. The limits count the
+// jobs of the polled calls created since the start of the day or the week, except the cancelled ones.
+func createJobs(app *App, rule model.CallQuestionnaireRule, callID string) error {
+	// a scheduled rule audits the calls stored before its last run
+	var storedBefore *time.Time
+	if rule.Schedule != "" {
+		last, err := lastRuleRun(&rule, time.Now())
+		if err != nil {
+			slog.Error("Invalid rule schedule",
+				slog.Int("rule_id", rule.Id),
+				slog.String("schedule", rule.Schedule),
+				slog.String("error", err.Error()))
+			return err
+		}
+		if last.IsZero() {
+			return nil
+		}
+		storedBefore = &last
+	}
+
+	lookback := time.Duration(app.config.CallEvents.LookbackSec) * time.Second
+	query, args := createJobsQuery(&rule, callID, storedBefore, app.config.Jobs.RuleMaxActive, lookback)
 	_, err := app.Store.ServiceStore().Execute(context.Background(), query, args...)
 	if err != nil {
 		slog.Error("Failed to create jobs for rule",
@@ -148,9 +295,513 @@ func createJobs(app *App, rule model.CallQuestionnaireRule) any {
 	return nil
 }
 
-func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
-	rules, err := app.Store.ServiceStore().Array(context.Background(),
-		`SELECT
+// createJobsQuery builds the insert of the jobs of the next calls of the rule within its quotas,
+// the days and weeks of the quotas start in the timezone of the rule. A poll moves the last_stored_at
+// of the rule up to the last call it has enqueued, the calls before it are enqueued or left out by
+// the rule. The calls stored within lookback before last_stored_at are looked at again, so a call
+// whose event or job creation has failed is enqueued late, the unique index of the polled jobs keeps it once.
+// It returns the query and its arguments.
+func createJobsQuery(rule *model.CallQuestionnaireRule, callID string, storedBefore *time.Time, maxActive int, lookback time.Duration) (string, []any) {
+	filter, filterArgs := callFilterSQL(rule, 35)
+	query := `
+		WITH audited AS (
+			SELECT
+				(j.params->>'agent_id')::int8 AS agent_id,
+				count(*) FILTER (WHERE j.created_at >= date_trunc('day', NOW(), $31::text)) AS day,
+				count(*) AS week
+			FROM call_audit.jobs j
+			WHERE j.rule_id = $1
+			AND j.backfill_id IS NULL
+			AND j.state <> $34
+			AND j.created_at >= date_trunc('week', NOW(), $31::text)
+			GROUP BY 1
+		), c AS (
+			SELECT h.id, h.domain_id, h.stored_at, h.agent_id,
+				row_number() OVER (PARTITION BY h.agent_id ORDER BY h.stored_at) AS agent_n
+			FROM call_center.cc_calls_history h
+			WHERE h.domain_id = $20
+			AND h.parent_id IS NULL
+			AND h.stored_at > least($21::timestamptz, NOW() - $32 * INTERVAL '1 second')
+			AND h.stored_at >= $33::timestamptz
+			AND h.payload->($7::text) IS NULL
+			AND h.talk_sec > $2
+			AND ($22::varchar IS NULL OR h.direction = $22::varchar)
+			AND ($24::text = '' OR h.id::text = $24::text)
+			AND ($29::timestamptz IS NULL OR h.stored_at <= $29::timestamptz)
+			AND ` + sampledCalls("$1", "$25") + filter + `
+			AND EXISTS (
+				SELECT 1
+				FROM storage.files f
+				WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
+			)
+			AND NOT EXISTS (
+				SELECT 1
+				FROM call_audit.jobs j
+				WHERE j.rule_id = $1 AND j.params->>'call_id' = h.id::text
+			)
+		), h AS (
+			SELECT c.*, row_number() OVER (ORDER BY c.stored_at) AS n
+			FROM c
+			LEFT JOIN audited a ON a.agent_id = c.agent_id
+			WHERE c.agent_id IS NULL
+			OR (
+				($26::int IS NULL OR c.agent_n + COALESCE(a.day, 0) <= $26::int)
+				AND ($27::int IS NULL OR c.agent_n + COALESCE(a.week, 0) <= $27::int)
+			)
+		)
+		), created AS (
+			INSERT INTO call_audit.jobs(rule_id, type, params)
+			SELECT
+				$1,
+				2,` + jobParams + `
+			FROM h
+			JOIN LATERAL (
+				SELECT f.id
+				FROM storage.files f
+				WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
+				LIMIT 1
+			) f ON true
+			WHERE $28::int IS NULL OR h.n + (SELECT COALESCE(sum(day), 0) FROM audited) <= $28::int
+			ORDER BY h.stored_at
+			LIMIT greatest($30::int - $23, 0)
+			ON CONFLICT (rule_id, (params->>'call_id')) WHERE backfill_id IS NULL AND priority = 0 DO NOTHING
+			RETURNING (params->>'stored_at')::timestamptz AS stored_at
+		)
+		UPDATE call_audit.call_questionnaire_rule r
+		SET last_stored_at = greatest(COALESCE(r.last_stored_at, r."from"), (SELECT max(stored_at) FROM created))
+		WHERE r.id = $1 AND $24::text = '' AND EXISTS (SELECT 1 FROM created)
+	`
+
+	args := append(jobParamsArgs(rule),
+		rule.DomainId,
+		rule.Last,
+		rule.CallDirection,
+		rule.Active,
+		callID,
+		rule.SamplePercent,
+		rule.AgentDailyLimit,
+		rule.AgentWeeklyLimit,
+		rule.DailyLimit,
+		storedBefore,
+		maxActive,
+		ruleTimezone(rule),
+		lookback.Seconds(),
+		rule.From,
+		model.JobStateCancelled,
+	)
+	args = append(args, filterArgs...)
+	return query, args
+}
+
+// lastRuleRun returns the last run of the rule schedule at or before now in the timezone of the rule,
+// the zero time when it has not run yet.
+func lastRuleRun(rule *model.CallQuestionnaireRule, now time.Time) (time.Time, error) {
+	schedule, err := util.ParseSchedule(rule.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := time.LoadLocation(rule.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return util.LastScheduled(schedule, now.In(loc)), nil
+}
+
+// sampledCalls is the condition of the calls h in the sample of percent of the rule. A call is
+// sampled by the hash of its id and the rule, so every poll picks the same calls.
+func sampledCalls(ruleID, percent string) string {
+	return `(` + percent + `::int IS NULL OR abs(hashtext(h.id::text || ':' || ` + ruleID + `::int)::int8) % 100 < ` + percent + `::int)`
+}
+
+// ruleCalls are the conditions of the stored calls h a rule audits,
+// minDuration and direction are the placeholders of the rule settings.
+func ruleCalls(minDuration, direction string) string {
+	return `
+		h.parent_id IS NULL
+		AND h.talk_sec > ` + minDuration + `
+		AND (NULLIF(` + direction + `::varchar, '') IS NULL OR h.direction = ` + direction + `::varchar)
+		AND EXISTS (
+			SELECT 1
+			FROM storage.files f
+			WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
+		)`
+}
+
+// ruleTimezone is the timezone of the domain of the rule, UTC when the domain has none.
+func ruleTimezone(rule *model.CallQuestionnaireRule) string {
+	if rule.Timezone == "" {
+		return "UTC"
+	}
+	return rule.Timezone
+}
+
+// callFilterSQL translates the call filter of the rule into conditions of the stored calls h,
+// each starting with AND, with the placeholders numbered from next. It returns the conditions and their arguments.
+func callFilterSQL(rule *model.CallQuestionnaireRule, next int) (string, []any) {
+	filter := rule.CallFilter
+	if filter == nil {
+		return "", nil
+	}
+	var (
+		b    strings.Builder
+		args []any
+	)
+	// arg binds the value to the next placeholder
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", next+len(args)-1)
+	}
+	// every ? of the condition is the placeholder of value
+	add := func(condition string, value any) {
+		b.WriteString("\n\t\tAND ")
+		b.WriteString(strings.ReplaceAll(condition, "?", arg(value)))
+	}
+	if len(filter.Queues) > 0 {
+		add("h.queue_id = ANY(?::int8[])", filter.Queues)
+	}
+	if len(filter.Teams) > 0 {
+		add("h.team_id = ANY(?::int8[])", filter.Teams)
+	}
+	if len(filter.Agents) > 0 {
+		add("h.agent_id = ANY(?::int8[])", filter.Agents)
+	}
+	if len(filter.Gateways) > 0 {
+		add("h.gateway_id = ANY(?::int8[])", filter.Gateways)
+	}
+	if len(filter.HangupCauses) > 0 {
+		add("h.cause = ANY(?::varchar[])", filter.HangupCauses)
+	}
+	if len(filter.Tags) > 0 {
+		add("h.tags && ?::varchar[]", filter.Tags)
+	}
+	if filter.Destination != "" {
+		add("h.destination ILIKE ?::varchar", util.SubstringMask(filter.Destination, '*', '?')[0])
+	}
+	if filter.Number != "" {
+		add("(h.from_number ILIKE ?::varchar OR h.to_number ILIKE ?::varchar)", util.SubstringMask(filter.Number, '*', '?')[0])
+	}
+	if len(filter.Variables) > 0 {
+		variables, _ := json.Marshal(filter.Variables)
+		add("h.payload @> ?::jsonb", string(variables))
+	}
+	if filter.MinDuration > 0 {
+		add("h.duration >= ?::int", filter.MinDuration)
+	}
+	if filter.MaxDuration > 0 {
+		add("h.duration <= ?::int", filter.MaxDuration)
+	}
+	if len(filter.TimeWindows) > 0 {
+		// the local time the call was placed at
+		local := "(h.created_at AT TIME ZONE " + arg(ruleTimezone(rule)) + "::text)"
+		minute := "(extract(hour FROM " + local + ") * 60 + extract(minute FROM " + local + "))"
+		windows := make([]string, 0, len(filter.TimeWindows))
+		for _, w := range filter.TimeWindows {
+			window := minute + " >= " + arg(w.StartMinute) + "::int AND " + minute + " < " + arg(w.EndMinute) + "::int"
+			if len(w.Days) > 0 {
+				window = "extract(isodow FROM " + local + ")::int = ANY(" + arg(w.Days) + "::int[]) AND " + window
+			}
+			windows = append(windows, "("+window+")")
+		}
+		b.WriteString("\n\t\tAND (" + strings.Join(windows, " OR ") + ")")
+	}
+	return b.String(), args
+}
+
+// countBackfillCalls returns the number of calls a backfill of the rule over the window would audit.
+func countBackfillCalls(app *App, rule *model.CallQuestionnaireRule, from, to time.Time) (int64, error) {
+	filter, filterArgs := callFilterSQL(rule, 6)
+	args := append([]any{rule.DomainId, from, to, rule.MinCallDuration, rule.CallDirection}, filterArgs...)
+	rows, err := app.Store.ServiceStore().Array(context.Background(), `
+		SELECT count(*) AS total
+		FROM call_center.cc_calls_history h
+		WHERE h.domain_id = $1
+		AND h.stored_at >= $2 AND h.stored_at <= $3
+		AND `+ruleCalls("$4", "$5")+filter,
+		args...)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	row, _ := rows[0].(map[string]any)
+	total, _ := rowInt(row["total"])
+	return total, nil
+}
+
+// runBackfills queues the next batch of every running backfill, a rule that no longer exists cancels its backfills.
+func runBackfills(app *App) {
+	rows, err := app.Store.ServiceStore().Array(context.Background(), `
+		SELECT id, domain_id, rule_id
+		FROM call_audit.backfills
+		WHERE state = $1
+		ORDER BY id
+	`, model.BackfillStateRunning)
+	if err != nil {
+		slog.Error("Failed to get backfills", slog.String("error", err.Error()))
+		return
+	}
+	for _, item := range rows {
+		row, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, _ := rowInt(row["id"])
+		domainID, _ := rowInt(row["domain_id"])
+		ruleID, _ := rowInt(row["rule_id"])
+
+		rule, err := getRule(app, domainID, ruleID)
+		if err != nil {
+			continue
+		}
+		if rule == nil {
+			slog.Warn("Backfill rule not found, cancelling the backfill", slog.Int64("backfill_id", id), slog.Int64("rule_id", ruleID))
+			_, err = app.Store.ServiceStore().Execute(context.Background(), `
+				UPDATE call_audit.backfills SET state = $2, updated_at = NOW() WHERE id = $1
+			`, id, model.BackfillStateCancelled)
+		} else if rule.PausedReason == "" {
+			err = queueBackfillBatch(app, id, rule)
+		}
+		if err != nil {
+			slog.Error("Failed to run backfill", slog.Int64("backfill_id", id), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// queueBackfillBatch tops the unfinished jobs of the backfill up to its batch size with the next calls
+// after the cursor and completes the backfill once the window is exhausted and its jobs are finished.
+// A backfill locked by another instance is skipped.
+func queueBackfillBatch(app *App, backfillID int64, rule *model.CallQuestionnaireRule) error {
+	filter, filterArgs := callFilterSQL(rule, 25)
+	query := `
+		WITH b AS (
+			SELECT b.id, b.domain_id, b."to", b.cursor_at, b.cursor_id, b.batch_size,
+				b.batch_size - (
+					SELECT count(*)
+					FROM call_audit.jobs j
+					WHERE j.backfill_id = b.id AND j.state = ANY($24::int[])
+				) AS free
+			FROM call_audit.backfills b
+			WHERE b.id = $20 AND b.state = $22
+			FOR UPDATE SKIP LOCKED
+		), h AS (
+			SELECT h.id, h.domain_id, h.stored_at, h.agent_id
+			FROM b, call_center.cc_calls_history h
+			WHERE h.domain_id = b.domain_id
+			AND (h.stored_at, h.id::text) > (b.cursor_at, b.cursor_id)
+			AND h.stored_at <= b."to"
+			AND ` + ruleCalls("$2", "$21") + filter + `
+			ORDER BY h.stored_at, h.id::text
+			LIMIT (SELECT greatest(free, 0) FROM b)
+		), ins AS (
+			INSERT INTO call_audit.jobs(rule_id, type, params, backfill_id)
+			SELECT
+				$1,
+				2,` + jobParams + `,
+				$20
+			FROM h
+			JOIN LATERAL (
+				SELECT f.id
+				FROM storage.files f
+				WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
+				LIMIT 1
+			) f ON true
+			WHERE NOT EXISTS (
+				SELECT 1
+				FROM call_audit.jobs j
+				WHERE j.rule_id = $1 AND j.params->>'call_id' = h.id::text AND j.state = ANY($24::int[])
+			)
+			RETURNING id
+		), last AS (
+			SELECT h.stored_at, h.id::text AS id
+			FROM h
+			ORDER BY h.stored_at DESC, h.id::text DESC
+			LIMIT 1
+		)
+		UPDATE call_audit.backfills bb
+		SET queued = bb.queued + (SELECT count(*) FROM ins),
+			cursor_at = COALESCE((SELECT stored_at FROM last), bb.cursor_at),
+			cursor_id = COALESCE((SELECT id FROM last), bb.cursor_id),
+			state = CASE WHEN b.free = b.batch_size AND NOT EXISTS (SELECT 1 FROM h) THEN $23 ELSE bb.state END,
+			updated_at = NOW()
+		FROM b
+		WHERE bb.id = b.id
+	`
+
+	args := append(jobParamsArgs(rule), backfillID, rule.CallDirection,
+		model.BackfillStateRunning, model.BackfillStateCompleted, unfinishedJobStates)
+	args = append(args, filterArgs...)
+	_, err := app.Store.ServiceStore().Execute(context.Background(), query, args...)
+	return err
+}
+
+// enqueueCalls creates a job of the given priority for every stored call of the list, skipping the
+// duration, direction and time window checks of the rule. Calls without a recording get no job.
+// It returns the job id of every enqueued call.
+func enqueueCalls(app *App, rule *model.CallQuestionnaireRule, callIDs []string, priority int) (map[string]int64, error) {
+	query := `
+		INSERT INTO call_audit.jobs(rule_id, type, params, priority)
+		SELECT
+			$1,
+			2,` + jobParams + `,
+			$22
+		FROM call_center.cc_calls_history h
+		JOIN LATERAL (
+			SELECT f.id
+			FROM storage.files f
+			WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
+			LIMIT 1
+		) f ON true
+		WHERE h.domain_id = $20
+		AND h.id::text = ANY($21::text[])
+		RETURNING id, params->>'call_id' AS call_id
+	`
+
+	args := append(jobParamsArgs(rule), rule.DomainId, callIDs, priority)
+	rows, err := app.Store.ServiceStore().Array(context.Background(), query, args...)
+	if err != nil {
+		slog.Error("Failed to enqueue calls",
+			slog.Int("rule_id", rule.Id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	jobs := make(map[string]int64, len(rows))
+	for _, item := range rows {
+		row, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, _ := rowInt(row["id"])
+		callID, _ := row["call_id"].(string)
+		jobs[callID] = id
+	}
+	return jobs, nil
+}
+
+// previewCall is a stored call matched by a rule with the params of the job it would get.
+type previewCall struct {
+	CallID    string
+	StoredAt  time.Time
+	Direction string
+	TalkSec   int32
+	Params    model.JobParams
+}
+
+// previewCalls returns the number of stored calls createJobs would match for the rule, sampled but ignoring
+// the limits of the rule and of unfinished jobs, and the first limit of them. Nothing is written.
+func previewCalls(app *App, rule *model.CallQuestionnaireRule, limit int) (int64, []previewCall, error) {
+	filter, filterArgs := callFilterSQL(rule, 25)
+	query := `
+		SELECT
+			h.id::text AS call_id,
+			h.stored_at,
+			h.direction,
+			h.talk_sec,
+			count(*) OVER () AS total,` + jobParams + ` AS params
+		FROM call_center.cc_calls_history h
+		JOIN LATERAL (
+			SELECT f.id
+			FROM storage.files f
+			WHERE f.domain_id = h.domain_id AND f.uuid = h.id::text
+			LIMIT 1
+		) f ON true
+		WHERE h.domain_id = $20
+		AND h.stored_at > $21
+		AND h.payload->($7::text) IS NULL
+		AND ` + ruleCalls("$2", "$22") + filter + `
+		AND ` + sampledCalls("$1", "$24") + `
+		AND NOT EXISTS (
+			SELECT 1
+			FROM call_audit.jobs j
+			WHERE j.rule_id = $1 AND j.params->>'call_id' = h.id::text
+		)
+		ORDER BY h.stored_at
+		LIMIT $23
+	`
+
+	args := append(jobParamsArgs(rule), rule.DomainId, rule.Last, rule.CallDirection, limit, rule.SamplePercent)
+	args = append(args, filterArgs...)
+	rows, err := app.Store.ServiceStore().Array(context.Background(), query, args...)
+	if err != nil {
+		slog.Error("Failed to preview rule calls", slog.String("error", err.Error()))
+		return 0, nil, err
+	}
+
+	var (
+		total int64
+		calls = make([]previewCall, 0, len(rows))
+	)
+	for _, item := range rows {
+		row, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		total, _ = rowInt(row["total"])
+		call := previewCall{Params: parseJobParams(row["params"])}
+		call.CallID, _ = row["call_id"].(string)
+		call.StoredAt, _ = row["stored_at"].(time.Time)
+		call.Direction, _ = row["direction"].(string)
+		if v, ok := rowInt(row["talk_sec"]); ok {
+			call.TalkSec = int32(v)
+		}
+		calls = append(calls, call)
+	}
+	return total, calls, nil
+}
+
+// resolveRuleProfiles reads the settings of the profiles of a rule that is not stored, like ruleSelect does.
+// It returns false when the cognitive profile is not found in the domain of the rule.
+func resolveRuleProfiles(app *App, rule *model.CallQuestionnaireRule) (bool, error) {
+	rows, err := app.Store.ServiceStore().Array(context.Background(), `
+		SELECT
+			lp.token AS language_token,
+			cp.properties->>'key' AS cognitive_key,
+			cp.properties->>'llm_provider' AS provider,
+			cp.properties->>'llm_base_url' AS provider_url,
+			cp.properties->>'llm_api_version' AS provider_api_version,
+			cp.properties->>'llm_deployment' AS provider_deployment,
+			COALESCE((
+				SELECT tz.sys_name
+				FROM directory.wbt_domain d
+				JOIN flow.calendar_timezones tz ON tz.id = d.timezone_id
+				WHERE d.dc = cp.domain_id
+			), 'UTC') AS timezone
+		FROM storage.cognitive_profile_services cp
+		LEFT JOIN storage.language_profiles lp ON lp.id = $2 AND lp.domain_id = cp.domain_id
+		WHERE cp.id = $1 AND cp.domain_id = $3
+	`, rule.CognitiveProfile, rule.LanguageProfile, rule.DomainId)
+	if err != nil {
+		slog.Error("Failed to get rule profiles", slog.String("error", err.Error()))
+		return false, err
+	}
+	if len(rows) == 0 {
+		return false, nil
+	}
+	row, _ := rows[0].(map[string]any)
+
+	// NULL settings fall back to the defaults, as in parseRules
+	languageToken, _ := row["language_token"].(string)
+	cognitiveKey, _ := row["cognitive_key"].(string)
+	provider, _ := row["provider"].(string)
+	providerURL, _ := row["provider_url"].(string)
+	providerAPIVersion, _ := row["provider_api_version"].(string)
+	providerDeployment, _ := row["provider_deployment"].(string)
+	rule.Timezone, _ = row["timezone"].(string)
+
+	rule.LanguageProfileToken = &languageToken
+	rule.CognitiveProfileToken = &cognitiveKey
+	rule.Provider = &provider
+	rule.ProviderURL = &providerURL
+	rule.ProviderAPIVersion = &providerAPIVersion
+	rule.ProviderDeployment = &providerDeployment
+	return true, nil
+}
+
+// ruleSelect reads the rules with the settings of their profiles and the number of unfinished jobs
+// outside of backfills, parsed by parseRules. states is the placeholder of unfinishedJobStates.
+func ruleSelect(states string) string {
+	return `SELECT
 			COALESCE(r.last_stored_at, r."from") AS last,
 			r.id,
 			r.domain_id,
@@ -172,24 +823,104 @@ func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
 			r.updated_by,
 			r.last_stored_at,
 			r.scorecard,
+			r.model,
+			r.temperature,
+			r.top_p,
+			r.max_output_tokens,
+			r.seed,
+			r.reasoning_effort,
+			r.call_filter::text AS call_filter,
+			r.chunking::text AS chunking,
+			r.sample_percent,
+			r.agent_daily_limit,
+			r.agent_weekly_limit,
+			r.daily_limit,
+			r.schedule,
+			r.priority,
+			COALESCE(r.paused_reason, '') AS paused_reason,
+			COALESCE((
+				SELECT tz.sys_name
+				FROM directory.wbt_domain d
+				JOIN flow.calendar_timezones tz ON tz.id = d.timezone_id
+				WHERE d.dc = r.domain_id
+			), 'UTC') AS timezone,
 			lp.token AS language_token,
 			cp.properties->>'key' AS cognitive_key,
+			cp.properties->>'llm_provider' AS provider,
+			cp.properties->>'llm_base_url' AS provider_url,
+			cp.properties->>'llm_api_version' AS provider_api_version,
+			cp.properties->>'llm_deployment' AS provider_deployment,
 			(
 				SELECT COUNT(*)
 				FROM call_audit.jobs j
-				WHERE j.rule_id = r.id
+				WHERE j.rule_id = r.id AND j.state = ANY(` + states + `::int[]) AND j.backfill_id IS NULL
 			) AS active
 		FROM call_audit.call_questionnaire_rule r
 		LEFT JOIN storage.language_profiles lp ON r.language_profile = lp.id
 		LEFT JOIN storage.cognitive_profile_services cp ON r.cognitive_profile = cp.id
+`
+}
+
+// budgetExhausted is the paused reason of the rules of a domain that has spent its monthly budget.
+const budgetExhausted = "monthly LLM budget of the domain is spent"
+
+// spentBudgets selects the domains that have spent their monthly budget.
+const spentBudgets = `
+			SELECT b.domain_id
+			FROM call_audit.budgets b
+			WHERE b.monthly_limit <= (
+				SELECT COALESCE(sum(u.cost), 0)
+				FROM call_audit.llm_usage u
+				WHERE u.domain_id = b.domain_id AND u.created_at >= date_trunc('month', NOW(), 'UTC')
+			)`
+
+// pauseRulesOverBudget pauses the rules of the domains that have spent their monthly budget
+// and resumes the rules paused by a budget that is raised, removed or renewed by a new month.
+func pauseRulesOverBudget(app *App) {
+	_, err := app.Store.ServiceStore().Execute(context.Background(), `
+		WITH spent AS (`+spentBudgets+`
+		)
+		UPDATE call_audit.call_questionnaire_rule r
+		SET paused_reason = CASE WHEN r.domain_id IN (SELECT domain_id FROM spent) THEN $1::varchar END
+		WHERE r.paused_reason IS DISTINCT FROM CASE WHEN r.domain_id IN (SELECT domain_id FROM spent) THEN $1::varchar END
+		AND (r.paused_reason IS NULL OR r.paused_reason = $1::varchar)
+	`, budgetExhausted)
+	if err != nil {
+		slog.Error("Failed to pause the rules over budget", slog.String("error", err.Error()))
+	}
+}
+
+// domainBudgetSpent reports whether the domain has spent its monthly budget.
+func domainBudgetSpent(ctx context.Context, app *App, domainID int64) (bool, error) {
+	rows, err := app.Store.ServiceStore().Array(ctx, `
+		SELECT EXISTS (`+spentBudgets+`
+			AND b.domain_id = $1
+		) AS spent
+	`, domainID)
+	if err != nil {
+		return false, err
+	}
+	if len(rows) == 0 {
+		return false, nil
+	}
+	spent, _ := rows[0].(map[string]interface{})["spent"].(bool)
+	return spent, nil
+}
+
+// getRules returns the enabled rules that are not paused with less than maxActive unfinished polled jobs,
+// the higher priority first.
+func getRules(app *App, maxActive int) (*[]model.CallQuestionnaireRule, error) {
+	rules, err := app.Store.ServiceStore().Array(context.Background(),
+		ruleSelect("$2")+`
 		WHERE r.enabled
+		AND r.paused_reason IS NULL
 		AND (
 				SELECT COUNT(*)
 				FROM call_audit.jobs j
-				WHERE j.rule_id = r.id
-			) < 100
-		ORDER BY last DESC;	
-		`)
+				WHERE j.rule_id = r.id AND j.state = ANY($2::int[]) AND j.backfill_id IS NULL
+			) < $1::int
+		ORDER BY r.priority DESC, last DESC;
+		`, maxActive, unfinishedJobStates)
 	if err != nil {
 		slog.Error("Failed to get active rules", slog.String("error", err.Error()))
 		return nil, err
@@ -198,8 +929,28 @@ func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
 		slog.Info("No active rules found")
 		return nil, nil
 	}
-	// process rules
+	return parseRules(rules), nil
+}
 
+// getRule returns the rule of the domain regardless of its state, nil when there is no such rule.
+func getRule(app *App, domainID int64, id int64) (*model.CallQuestionnaireRule, error) {
+	rows, err := app.Store.ServiceStore().Array(context.Background(),
+		ruleSelect("$3")+`
+		WHERE r.id = $1 AND r.domain_id = $2
+		`, id, domainID, unfinishedJobStates)
+	if err != nil {
+		slog.Error("Failed to get rule", slog.Int64("rule_id", id), slog.String("error", err.Error()))
+		return nil, err
+	}
+	rules := *parseRules(rows)
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return &rules[0], nil
+}
+
+// parseRules converts the rows selected with ruleSelect, a row with a missing required field is skipped.
+func parseRules(rules []any) *[]model.CallQuestionnaireRule {
 	var processedRules []model.CallQuestionnaireRule
 	for _, ruleMap := range rules {
 		ruleData, ok := ruleMap.(map[string]interface{})
@@ -252,6 +1003,11 @@ func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
 			slog.Error("cognitive_key field is not a string")
 			continue
 		}
+		from, _ := ruleData["from"].(time.Time)
+		var to *time.Time
+		if v, ok := ruleData["to"].(time.Time); ok {
+			to = &v
+		}
 		scorecard, ok := ruleData["scorecard"].(int32)
 		if !ok {
 			slog.Error("scorecard field is not an int")
@@ -273,6 +1029,59 @@ func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
 		if !ok {
 			slog.Error("default_promt field is not a string")
 		}
+		// optional provider settings, NULL falls back to OpenAI defaults
+		provider, _ := ruleData["provider"].(string)
+		providerURL, _ := ruleData["provider_url"].(string)
+		providerAPIVersion, _ := ruleData["provider_api_version"].(string)
+		providerDeployment, _ := ruleData["provider_deployment"].(string)
+
+		// optional generation parameters, NULL keeps the provider defaults
+		var (
+			modelName       *string
+			temperature     *float64
+			topP            *float64
+			maxOutputTokens *int32
+			seed            *int64
+			reasoningEffort *string
+		)
+		if v, ok := ruleData["model"].(string); ok && v != "" {
+			modelName = &v
+		}
+		if v, ok := ruleData["temperature"].(float64); ok {
+			temperature = &v
+		}
+		if v, ok := ruleData["top_p"].(float64); ok {
+			topP = &v
+		}
+		if v, ok := ruleData["max_output_tokens"].(int32); ok {
+			maxOutputTokens = &v
+		}
+		if v, ok := ruleData["seed"].(int64); ok {
+			seed = &v
+		}
+		if v, ok := ruleData["reasoning_effort"].(string); ok && v != "" {
+			reasoningEffort = &v
+		}
+
+		schedule, _ := ruleData["schedule"].(string)
+		priority, _ := ruleData["priority"].(int32)
+		pausedReason, _ := ruleData["paused_reason"].(string)
+		timezone, _ := ruleData["timezone"].(string)
+
+		// optional sampling and limits, NULL audits every polled call
+		var samplePercent, agentDailyLimit, agentWeeklyLimit, dailyLimit *int32
+		if v, ok := ruleData["sample_percent"].(int32); ok {
+			samplePercent = &v
+		}
+		if v, ok := ruleData["agent_daily_limit"].(int32); ok {
+			agentDailyLimit = &v
+		}
+		if v, ok := ruleData["agent_weekly_limit"].(int32); ok {
+			agentWeeklyLimit = &v
+		}
+		if v, ok := ruleData["daily_limit"].(int32); ok {
+			dailyLimit = &v
+		}
 
 		rule := model.CallQuestionnaireRule{
 			Last:                  lastTime,
@@ -280,6 +1089,8 @@ func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
 			DomainId:              int(domainId),
 			Active:                int32(active),
 			CallDirection:         callDirection,
+			From:                  from,
+			To:                    to,
 			Name:                  name,
 			Description:           &description,
 			LanguageProfileToken:  &languageToken,
@@ -289,29 +1100,85 @@ func getRules(app *App, limit int) (*[]model.CallQuestionnaireRule, error) {
 			Variable:              &variable,
 			MinCallDuration:       &minCallDuration,
 			DefaultPrompt:         &defaultPrompt,
+			Provider:              &provider,
+			ProviderURL:           &providerURL,
+			ProviderAPIVersion:    &providerAPIVersion,
+			ProviderDeployment:    &providerDeployment,
+			Model:                 modelName,
+			Temperature:           temperature,
+			TopP:                  topP,
+			MaxOutputTokens:       maxOutputTokens,
+			Seed:                  seed,
+			ReasoningEffort:       reasoningEffort,
+			CallFilter:            parseCallFilter(ruleData["call_filter"]),
+			Chunking:              parseChunking(ruleData["chunking"]),
+			SamplePercent:         samplePercent,
+			AgentDailyLimit:       agentDailyLimit,
+			AgentWeeklyLimit:      agentWeeklyLimit,
+			DailyLimit:            dailyLimit,
+			Schedule:              schedule,
+			Timezone:              timezone,
+			Priority:              priority,
+			PausedReason:          pausedReason,
 		}
 		processedRules = append(processedRules, rule)
 	}
-	return &processedRules, nil
+	return &processedRules
 }
 
-// getJobs retrieves jobs from the database, updating their state to "in progress" (1).
-// getJobs retrieves jobs from the database, updating their state to "in progress" (1).
-func getJobs(app *App) ([]model.CallJob, error) {
+// getJobs leases up to limit pending jobs, jobs due for a retry and running jobs whose lease has expired to this instance,
+// updating their state to running and counting the attempt. Rows locked by another instance are skipped,
+// so every job is claimed once.
+//
+// The jobs are picked by weighted round robin: the higher job priority first, then the domains take turns,
+// and within a domain each rule gets turns in proportion to its priority. A domain with domainMaxRunning
+// running jobs across the instances gets no more jobs, 0 is no cap.
+func getJobs(app *App, lease time.Duration, limit int, domainMaxRunning int) ([]model.CallJob, error) {
 	raw, err := app.Store.ServiceStore().Array(context.Background(), `
+		WITH running AS (
+			SELECT r.domain_id, count(*) AS n
+			FROM call_audit.jobs j
+			JOIN call_audit.call_questionnaire_rule r ON r.id = j.rule_id
+			WHERE j.state = $6 AND j.lease_until >= NOW()
+			GROUP BY r.domain_id
+		), ready AS (
+			SELECT j.id, j.priority, COALESCE(r.domain_id, 0) AS domain_id,
+				row_number() OVER (PARTITION BY j.rule_id ORDER BY j.priority DESC, j.id)::float8
+					/ greatest(COALESCE(r.priority, 1), 1) AS rule_turn
+			FROM call_audit.jobs j
+			LEFT JOIN call_audit.call_questionnaire_rule r ON r.id = j.rule_id
+			WHERE j.state = $5
+			OR (j.state = $7 AND j.next_run_at <= NOW())
+			OR (j.state = $6 AND j.lease_until < NOW())
+		), turns AS (
+			SELECT ready.*,
+				row_number() OVER (PARTITION BY ready.domain_id ORDER BY ready.priority DESC, ready.rule_turn, ready.id) AS domain_turn
+			FROM ready
+		), picked AS (
+			SELECT t.id
+			FROM turns t
+			LEFT JOIN running ON running.domain_id = t.domain_id
+			WHERE $4::int = 0 OR COALESCE(running.n, 0) + t.domain_turn <= $4::int
+			ORDER BY t.priority DESC, t.domain_turn, t.rule_turn, t.id
+			LIMIT $3
+		)
 		UPDATE call_audit.jobs jj
-		SET state = 1
+		SET state = $6, attempts = jj.attempts + 1, locked_by = $1, lease_until = NOW() + $2 * INTERVAL '1 second', updated_at = NOW()
 		FROM (
-			SELECT *
+			SELECT id
 			FROM call_audit.jobs
-			WHERE state = 0
-			ORDER BY id
-			FOR UPDATE
-			LIMIT 10
+			WHERE id IN (SELECT id FROM picked)
+			AND (
+				state = $5
+				OR (state = $7 AND next_run_at <= NOW())
+				OR (state = $6 AND lease_until < NOW())
+			)
+			FOR UPDATE SKIP LOCKED
 		) j
 		WHERE j.id = jj.id
-		RETURNING *;
-	`)
+		RETURNING jj.*;
+	`, app.instanceID, lease.Seconds(), limit, domainMaxRunning,
+		model.JobStatePending, model.JobStateRunning, model.JobStateRetryScheduled)
 	if err != nil {
 		slog.Error("Failed to update jobs table", slog.String("error", err.Error()))
 		return nil, err
@@ -327,34 +1194,33 @@ func getJobs(app *App) ([]model.CallJob, error) {
 
 		var job model.CallJob
 
-		if v, ok := row["id"].(int64); ok {
+		if v, ok := rowInt(row["id"]); ok {
 			job.ID = v
 		}
-		if v, ok := row["rule_id"].(int64); ok {
+		if v, ok := rowInt(row["rule_id"]); ok {
 			job.RuleID = v
 		}
-		if v, ok := row["type"].(int64); ok {
+		if v, ok := rowInt(row["type"]); ok {
 			job.Type = int(v)
 		}
-		if v, ok := row["state"].(int64); ok {
-			job.State = int(v)
+		if v, ok := rowInt(row["state"]); ok {
+			job.State = model.JobState(v)
 		}
-		if v, ok := row["call_stored_at"].(time.Time); ok {
-			job.CallStoredAt = v
+		if v, ok := rowInt(row["attempts"]); ok {
+			job.Attempts = int(v)
 		}
-
-		// Handle JSONB column `params`
-		var params model.JobParams
-		switch val := row["params"].(type) {
-		case map[string]any:
-			jsonBytes, _ := json.Marshal(val)
-			_ = json.Unmarshal(jsonBytes, &params)
-		case string:
-			_ = json.Unmarshal([]byte(val), &params)
-		case []byte:
-			_ = json.Unmarshal(val, &params)
+		if v, ok := rowInt(row["priority"]); ok {
+			job.Priority = int(v)
+		}
+		if v, ok := row["next_run_at"].(time.Time); ok {
+			job.NextRunAt = &v
 		}
-		job.Params = params
+		if v, ok := row["error"].(string); ok {
+			job.Error = &v
+		}
+
+		job.Params = parseJobParams(row["params"])
+		job.CallStoredAt = job.Params.StoredAt
 
 		jobs = append(jobs, job)
 	}
@@ -362,25 +1228,96 @@ func getJobs(app *App) ([]model.CallJob, error) {
 	return jobs, nil
 }
 
+// parseCallFilter reads the call_filter column selected as text, NULL or an invalid filter matches any call.
+func parseCallFilter(v any) *model.CallFilter {
+	data, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	var filter pb.CallFilter
+	if err := protojson.Unmarshal([]byte(data), &filter); err != nil {
+		slog.Error("call_filter field is not a CallFilter", slog.String("error", err.Error()))
+		return nil
+	}
+	return callFilterFromProto(&filter)
+}
+
+// parseChunking reads the chunking column selected as text, NULL or an invalid setting is the default.
+func parseChunking(v any) *model.TranscriptChunking {
+	data, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	var chunking pb.TranscriptChunking
+	if err := protojson.Unmarshal([]byte(data), &chunking); err != nil {
+		slog.Error("chunking field is not a TranscriptChunking", slog.String("error", err.Error()))
+		return nil
+	}
+	return chunkingFromProto(&chunking)
+}
+
+// parseJobParams reads the json `params` column of a job.
+func parseJobParams(v any) model.JobParams {
+	var params model.JobParams
+	switch val := v.(type) {
+	case map[string]any:
+		jsonBytes, _ := json.Marshal(val)
+		_ = json.Unmarshal(jsonBytes, &params)
+	case string:
+		_ = json.Unmarshal([]byte(val), &params)
+	case []byte:
+		_ = json.Unmarshal(val, &params)
+	}
+	return params
+}
+
+// rowInt reads an integer column of any width, int4 columns are returned as int32.
+func rowInt(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
 func StartJobs(app *App) {
 
-	slog.Info("Start jobs execution")
-	dropAllJobs(app)
+	slog.Info("Start jobs execution", slog.String("instance", app.instanceID))
+	cfg := processor.LoadConfig()
+	lease := max(time.Duration(cfg.JobLeaseSec)*time.Second, 3*time.Second)
 
-	//Get rules and create jobs
+	// Get rules and create jobs. While call events are consumed the jobs are created on
+	// arrival and the poller only reconciles the calls missed by the events.
 	go func() {
 		ticker := time.NewTicker(10 * time.Second)
 		defer ticker.Stop()
 
+		reconcile := time.Duration(app.config.CallEvents.ReconcileSec) * time.Second
+		var polledAt time.Time
 		for range ticker.C {
-			rules, err := getRules(app, 100)
+			pauseRulesOverBudget(app)
+			rules, err := getRules(app, app.config.Jobs.RuleMaxActive)
 			if err != nil {
 				slog.Error("Failed to get rules", slog.String("error", err.Error()))
 				continue
 			}
+			if rules == nil {
+				app.rules.set(nil)
+				continue
+			}
+			app.rules.set(*rules)
+
+			if app.callEvents != nil && app.callEvents.Connected() && time.Since(polledAt) < reconcile {
+				continue
+			}
+			polledAt = time.Now()
 
 			for _, rule := range *rules {
-				createJobs(app, rule)
+				createJobs(app, rule, "")
 				slog.Info("Created jobs for rule",
 					slog.Int64("rule_id", int64(rule.Id)),
 					slog.Int64("active", int64(rule.Active)),
@@ -389,36 +1326,50 @@ func StartJobs(app *App) {
 		}
 	}()
 
-	// Start a goroutine to drop finished jobs every second
+	// Queue the next batches of the running backfills
 	go func() {
-		ticker := time.NewTicker(1 * time.Second)
+		ticker := time.NewTicker(10 * time.Second)
 		defer ticker.Stop()
 		for range ticker.C {
-			dropFinishedJobs(app)
+			runBackfills(app)
 		}
 	}()
 
-	// Create a pool with 100 workers and a queue size of 10
-	p := pool.NewPool(100, 10)
+	// Create a pool of the configured workers, jobs are claimed for the free workers only
+	// so the jobs left are picked by the other instances
+	workers := app.config.Jobs.Workers
+	p := pool.NewPool(workers, app.config.Jobs.QueueSize)
+	var running atomic.Int32
 
 	// Register the worker function
 	go func() {
-		cfg := processor.LoadConfig()
-		procApp := processor.NewApp(cfg)
+		var events processor.EventPublisher
+		if app.auditEvents != nil {
+			events = app.auditEvents
+		}
+		procApp := processor.NewApp(cfg, app.Store.ServiceStore(), events)
 		ticker := time.NewTicker(1 * time.Second)
 		defer ticker.Stop()
 
 		for range ticker.C {
-			jobs, err := getJobs(app)
+			free := workers - int(running.Load())
+			if free <= 0 {
+				continue
+			}
+			jobs, err := getJobs(app, lease, free, app.config.Jobs.DomainMaxRunning)
 			if err != nil {
 				slog.Error("Failed to get jobs", slog.String("error", err.Error()))
 				continue
 			}
 
 			for _, job := range jobs {
+				running.Add(1)
 				p.Exec(&AppJobTask{
-					App: procApp,
-					Job: job,
+					App:     procApp,
+					Job:     job,
+					app:     app,
+					lease:   lease,
+					running: &running,
 				})
 				slog.Info("Submitted job", slog.String("uuid", job.Params.CallID))
 			}
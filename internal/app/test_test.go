@@ -0,0 +1,114 @@
+package app
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/webitel/call_audit/model"
+)
+
+var (
+	placeholderRe = regexp.MustCompile(`\$(\d+)`)
+	quotaDayRe    = regexp.MustCompile(`date_trunc\('(day|week)', NOW\(\), \$(\d+)::text\)`)
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func TestCreateJobsQuery(t *testing.T) {
+	storedBefore := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		rule         model.CallQuestionnaireRule
+		storedBefore *time.Time
+		timezone     string
+	}{
+		{
+			name:     "without quotas",
+			rule:     model.CallQuestionnaireRule{Id: 1, DomainId: 1, Timezone: "Europe/Kyiv"},
+			timezone: "Europe/Kyiv",
+		},
+		{
+			name: "quotas and sample",
+			rule: model.CallQuestionnaireRule{
+				Id: 2, DomainId: 1, Timezone: "America/New_York",
+				SamplePercent: int32Ptr(10), AgentDailyLimit: int32Ptr(3), AgentWeeklyLimit: int32Ptr(10), DailyLimit: int32Ptr(50),
+			},
+			storedBefore: &storedBefore,
+			timezone:     "America/New_York",
+		},
+		{
+			name: "call filter",
+			rule: model.CallQuestionnaireRule{
+				Id: 3, DomainId: 1, DailyLimit: int32Ptr(5),
+				CallFilter: &model.CallFilter{
+					Queues:      []int64{1, 2},
+					Number:      "380*",
+					TimeWindows: []model.CallTimeWindow{{Days: []int32{1, 2}, StartMinute: 540, EndMinute: 1080}},
+				},
+			},
+			timezone: "UTC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := createJobsQuery(&tt.rule, "", tt.storedBefore, 100, time.Hour)
+
+			used := map[int]bool{}
+			for _, m := range placeholderRe.FindAllStringSubmatch(query, -1) {
+				n, _ := strconv.Atoi(m[1])
+				if n < 1 || n > len(args) {
+					t.Fatalf("placeholder $%d has no argument, %d arguments", n, len(args))
+				}
+				used[n] = true
+			}
+			for n := 1; n <= len(args); n++ {
+				if !used[n] {
+					t.Errorf("argument %d is not used", n)
+				}
+			}
+
+			quotas := quotaDayRe.FindAllStringSubmatch(query, -1)
+			if len(quotas) != 2 {
+				t.Fatalf("quotas truncate %d times; want the day and the week", len(quotas))
+			}
+			for _, m := range quotas {
+				n, _ := strconv.Atoi(m[2])
+				if args[n-1] != tt.timezone {
+					t.Errorf("%s of the quotas starts in %v; want %v", m[1], args[n-1], tt.timezone)
+				}
+			}
+
+			for _, a := range []struct {
+				name string
+				n    int
+				want *int32
+			}{
+				{name: "sample percent", n: 25, want: tt.rule.SamplePercent},
+				{name: "agent daily limit", n: 26, want: tt.rule.AgentDailyLimit},
+				{name: "agent weekly limit", n: 27, want: tt.rule.AgentWeeklyLimit},
+				{name: "daily limit", n: 28, want: tt.rule.DailyLimit},
+			} {
+				if got := args[a.n-1]; got != a.want {
+					t.Errorf("%s $%d = %v; want %v", a.name, a.n, got, a.want)
+				}
+			}
+			if got := args[28]; got != tt.storedBefore {
+				t.Errorf("stored before $29 = %v; want %v", got, tt.storedBefore)
+			}
+			if got := args[31]; got != time.Hour.Seconds() {
+				t.Errorf("lookback $32 = %v; want %v", got, time.Hour.Seconds())
+			}
+		})
+	}
+}
+
+func TestSampledCalls(t *testing.T) {
+	want := `($25::int IS NULL OR abs(hashtext(h.id::text || ':' || $1::int)::int8) % 100 < $25::int)`
+	if got := sampledCalls("$1", "$25"); got != want {
+		t.Errorf("sampledCalls() = %s; want %s", got, want)
+	}
+}
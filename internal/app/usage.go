@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	pb "github.com/webitel/call_audit/api/call_audit"
+	cerror "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/util"
+	grpcopts "github.com/webitel/call_audit/model/options/grpc"
+)
+
+type UsageService struct {
+	app *App
+	pb.UnimplementedUsageServiceServer
+}
+
+func NewUsageService(app *App) (*UsageService, error) {
+	return &UsageService{app: app}, nil
+}
+
+// Search returns the LLM usage of the domain per day, rule and model.
+func (s *UsageService) Search(ctx context.Context, req *pb.SearchUsageRequest) (*pb.UsageList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(ctx, grpcopts.WithPagination(req))
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.usage.search.invalid_args", err.Error())
+	}
+	if v := req.GetRuleId(); len(v) > 0 {
+		searchOpts.AddFilter("rule_id", v)
+	}
+	if v := req.GetFrom(); v != nil {
+		searchOpts.AddFilter("from", v.AsTime())
+	}
+	if v := req.GetTo(); v != nil {
+		searchOpts.AddFilter("to", v.AsTime())
+	}
+
+	items, err := s.app.Store.Usage().Search(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search usage: %w", err)
+	}
+	items, next := util.ResolvePaging(searchOpts.GetSize(), items)
+
+	return &pb.UsageList{
+		Items: items,
+		Page:  int32(searchOpts.GetPage()),
+		Next:  next,
+	}, nil
+}
+
+func (s *UsageService) GetBudget(ctx context.Context, _ *pb.GetBudgetRequest) (*pb.Budget, error) {
+	searchOpts, err := grpcopts.NewLocateOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.usage.get_budget.invalid_args", err.Error())
+	}
+	budget, err := s.app.Store.Usage().GetBudget(searchOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget: %w", err)
+	}
+	return budget, nil
+}
+
+// SetBudget sets the monthly budget of the domain, the rules are paused or resumed by the next poll.
+func (s *UsageService) SetBudget(ctx context.Context, req *pb.SetBudgetRequest) (*pb.Budget, error) {
+	limit := req.GetMonthlyLimit()
+	if limit <= 0 || math.IsInf(limit, 0) || math.IsNaN(limit) {
+		return nil, cerror.NewBadRequestError("app.usage.set_budget.invalid_monthly_limit", "monthly_limit must be positive, delete the budget to remove the limit")
+	}
+	updateOpts, err := grpcopts.NewUpdateOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.usage.set_budget.invalid_args", err.Error())
+	}
+	budget, err := s.app.Store.Usage().SetBudget(updateOpts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set budget: %w", err)
+	}
+	return budget, nil
+}
+
+// DeleteBudget removes the monthly budget of the domain, its rules are resumed by the next poll.
+func (s *UsageService) DeleteBudget(ctx context.Context, _ *pb.DeleteBudgetRequest) (*pb.Budget, error) {
+	deleteOpts, err := grpcopts.NewDeleteOptions(ctx)
+	if err != nil {
+		return nil, cerror.NewBadRequestError("app.usage.delete_budget.invalid_args", err.Error())
+	}
+	budget, err := s.app.Store.Usage().DeleteBudget(deleteOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete budget: %w", err)
+	}
+	return budget, nil
+}
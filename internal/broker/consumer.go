@@ -0,0 +1,159 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	consumerPrefetch = 10
+	// requeueDelay holds a failed delivery back, so a message failing on every attempt does not spin
+	requeueDelay = 5 * time.Second
+)
+
+// Handler processes a single delivery. A message of a returned error is requeued,
+// of an error wrapped by Permanent dropped.
+type Handler func(ctx context.Context, routingKey string, body []byte) error
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks the error of a message that fails on every delivery, e.g. a malformed one.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// Consumer keeps a durable queue bound to a topic exchange and passes every delivery
+// to the handler, reconnecting with a growing delay when the connection drops.
+// The queue is shared, so several service instances split the messages between them.
+type Consumer struct {
+	url      string
+	exchange string
+	queue    string
+	keys     []string
+	handler  Handler
+
+	connected atomic.Bool
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewConsumer creates a consumer of the queue bound to exchange with the routing keys.
+func NewConsumer(url, exchange, queue string, keys []string, handler Handler) *Consumer {
+	return &Consumer{
+		url:      url,
+		exchange: exchange,
+		queue:    queue,
+		keys:     keys,
+		handler:  handler,
+	}
+}
+
+// Start runs the consume loop in background until Stop.
+func (c *Consumer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		reconnectLoop(ctx, "consumer "+c.queue, func(ctx context.Context) error {
+			defer c.connected.Store(false)
+			return c.consume(ctx)
+		})
+	}()
+}
+
+// Connected reports whether the consumer currently receives messages.
+func (c *Consumer) Connected() bool {
+	return c.connected.Load()
+}
+
+// Stop closes the connection and waits for the in-flight delivery.
+func (c *Consumer) Stop() {
+	if c.cancel == nil {
+		return
+	}
+	c.cancel()
+	c.wg.Wait()
+}
+
+// consume declares the topology and handles deliveries until the connection or ctx is closed.
+func (c *Consumer) consume(ctx context.Context) error {
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(c.exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare exchange %s: %w", c.exchange, err)
+	}
+	if _, err := ch.QueueDeclare(c.queue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("declare queue %s: %w", c.queue, err)
+	}
+	for _, key := range c.keys {
+		if err := ch.QueueBind(c.queue, key, c.exchange, false, nil); err != nil {
+			return fmt.Errorf("bind queue %s to %s: %w", c.queue, key, err)
+		}
+	}
+	if err := ch.Qos(consumerPrefetch, 0, false); err != nil {
+		return err
+	}
+	deliveries, err := ch.ConsumeWithContext(ctx, c.queue, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	c.connected.Store(true)
+	slog.Info("broker.consumer.connected", slog.String("exchange", c.exchange), slog.String("queue", c.queue))
+
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-closed:
+			return err
+		case d, ok := <-deliveries:
+			if !ok {
+				return fmt.Errorf("deliveries channel closed")
+			}
+			if err := c.handler(ctx, d.RoutingKey, d.Body); err != nil {
+				var permanent *permanentError
+				requeue := !errors.As(err, &permanent)
+				slog.Error("broker.consumer.handle_error",
+					slog.String("routing_key", d.RoutingKey),
+					slog.Bool("requeue", requeue),
+					slog.String("error", err.Error()))
+				if requeue {
+					select {
+					case <-ctx.Done():
+						return nil
+					case <-time.After(requeueDelay):
+					}
+				}
+				_ = d.Nack(false, requeue)
+				continue
+			}
+			_ = d.Ack(false)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const publishConfirmTimeout = 10 * time.Second
+
+type message struct {
+	routingKey string
+	body       []byte
+}
+
+// Publisher sends messages to a topic exchange with publisher confirms. Messages are
+// buffered locally and a message the broker has not confirmed is resent after reconnecting,
+// so a broker outage only delays the messages until the buffer is full.
+type Publisher struct {
+	url      string
+	exchange string
+	buffer   chan message
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPublisher creates a publisher to the exchange buffering up to bufferSize messages.
+func NewPublisher(url, exchange string, bufferSize int) *Publisher {
+	return &Publisher{
+		url:      url,
+		exchange: exchange,
+		buffer:   make(chan message, bufferSize),
+	}
+}
+
+// Start runs the publish loop in background until Stop.
+func (p *Publisher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		var pending *message
+		reconnectLoop(ctx, "publisher "+p.exchange, func(ctx context.Context) error {
+			return p.publish(ctx, &pending)
+		})
+	}()
+}
+
+// Publish queues the message without blocking, the message is dropped when the buffer is full.
+func (p *Publisher) Publish(routingKey string, body []byte) {
+	select {
+	case p.buffer <- message{routingKey: routingKey, body: body}:
+	default:
+		slog.Error("broker.publisher.buffer_full",
+			slog.String("exchange", p.exchange),
+			slog.String("routing_key", routingKey))
+	}
+}
+
+// Stop closes the connection, messages still in the buffer are lost.
+func (p *Publisher) Stop() {
+	if p.cancel == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+	if n := len(p.buffer); n > 0 {
+		slog.Warn("broker.publisher.stopped_with_pending", slog.String("exchange", p.exchange), slog.Int("messages", n))
+	}
+}
+
+// publish sends the buffered messages one by one waiting for the broker confirm.
+// A message that failed stays in pending and is sent first on the next connection.
+func (p *Publisher) publish(ctx context.Context, pending **message) error {
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(p.exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		return err
+	}
+	if err := ch.Confirm(false); err != nil {
+		return err
+	}
+	slog.Info("broker.publisher.connected", slog.String("exchange", p.exchange))
+
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	for {
+		if *pending == nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case err := <-closed:
+				return err
+			case msg := <-p.buffer:
+				*pending = &msg
+			}
+		}
+
+		if err := p.send(ctx, ch, *pending); err != nil {
+			return err
+		}
+		*pending = nil
+	}
+}
+
+func (p *Publisher) send(ctx context.Context, ch *amqp.Channel, msg *message) error {
+	ctx, cancel := context.WithTimeout(ctx, publishConfirmTimeout)
+	defer cancel()
+
+	confirm, err := ch.PublishWithDeferredConfirmWithContext(ctx, p.exchange, msg.routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         msg.body,
+	})
+	if err != nil {
+		return err
+	}
+	acked, err := confirm.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return errors.New("message is not confirmed by the broker")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const (
+	reconnectMinDelay = time.Second
+	reconnectMaxDelay = 30 * time.Second
+)
+
+// reconnectLoop runs the connection session until ctx is done, restarting it with
+// a doubling delay. The delay is reset once a session has been up for a while.
+func reconnectLoop(ctx context.Context, name string, session func(ctx context.Context) error) {
+	delay := reconnectMinDelay
+	for {
+		started := time.Now()
+		err := session(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(started) > reconnectMaxDelay {
+			delay = reconnectMinDelay
+		}
+		slog.Warn("broker.disconnected",
+			slog.String("name", name),
+			slog.String("error", fmt.Sprint(err)),
+			slog.Duration("retry_in", delay))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, reconnectMaxDelay)
+	}
+}
@@ -139,6 +139,10 @@ func NewForbiddenError(id string, details string) AppError {
 	return newAppError(id, details).SetStatusCode(http.StatusForbidden)
 }
 
+func NewConflictError(id string, details string) AppError {
+	return newAppError(id, details).SetStatusCode(http.StatusConflict)
+}
+
 func newAppError(id string, details string) AppError {
 	return &ApplicationError{Id: id, Status: id, DetailedError: details}
 }
@@ -145,3 +145,10 @@ func NewDBNotFoundError(id, message string) *DBNotFoundError {
 		DBError: *NewDBError(id, message),
 	}
 }
+
+// NewDBConflictError creates a new DBConflictError with the specified ID and message.
+func NewDBConflictError(id, message string) *DBConflictError {
+	return &DBConflictError{
+		DBError: *NewDBError(id, message),
+	}
+}
@@ -0,0 +1,9 @@
+-- call_audit.jobs search by the job service
+
+ALTER TABLE call_audit.jobs
+	ADD COLUMN IF NOT EXISTS created_at timestamptz DEFAULT now() NOT NULL;
+
+CREATE INDEX IF NOT EXISTS jobs_rule_id_state_idx ON call_audit.jobs (rule_id, state);
+CREATE INDEX IF NOT EXISTS jobs_call_id_idx ON call_audit.jobs ((params->>'call_id'));
+
+COMMENT ON COLUMN call_audit.jobs.state IS '0 pending, 1 running, 3 succeeded, 4 failed, 5 retry_scheduled, 6 dead, 7 cancelled';
@@ -0,0 +1,6 @@
+-- call_audit.jobs priority, jobs of a higher priority are claimed first
+
+ALTER TABLE call_audit.jobs
+	ADD COLUMN IF NOT EXISTS priority int4 DEFAULT 0 NOT NULL;
+
+COMMENT ON COLUMN call_audit.jobs.priority IS 'manual audits are enqueued with a higher priority than the rule polling';
@@ -0,0 +1,39 @@
+-- call_audit.backfills definition
+-- Audit of the past calls of a rule, queued in batches of batch_size unfinished jobs
+-- from the cursor (cursor_at, cursor_id) up to "to".
+
+CREATE TABLE call_audit.backfills (
+	id bigserial NOT NULL,
+	domain_id int8 NOT NULL,
+	rule_id int4 NOT NULL,
+	"from" timestamptz NOT NULL,
+	"to" timestamptz NOT NULL,
+	state int4 DEFAULT 0 NOT NULL,
+	batch_size int4 DEFAULT 50 NOT NULL,
+	total int8 DEFAULT 0 NOT NULL,
+	queued int8 DEFAULT 0 NOT NULL,
+	done int8 DEFAULT 0 NOT NULL,
+	cursor_at timestamptz NOT NULL,
+	cursor_id varchar DEFAULT '' NOT NULL,
+	created_at timestamptz DEFAULT now() NOT NULL,
+	created_by int8 NULL,
+	updated_at timestamptz DEFAULT now() NOT NULL,
+	updated_by int8 NULL,
+	CONSTRAINT backfills_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX backfills_domain_id_rule_id_idx ON call_audit.backfills USING btree (domain_id, rule_id);
+
+COMMENT ON COLUMN call_audit.backfills.state IS '0 running, 1 paused, 2 cancelled, 3 completed';
+COMMENT ON COLUMN call_audit.backfills.total IS 'calls matching the rule in the window when the backfill was created';
+COMMENT ON COLUMN call_audit.backfills.done IS 'succeeded jobs, the failed ones are counted from call_audit.jobs';
+
+-- Permissions
+
+ALTER TABLE call_audit.backfills OWNER TO opensips;
+GRANT ALL ON TABLE call_audit.backfills TO opensips;
+
+ALTER TABLE call_audit.jobs
+	ADD COLUMN IF NOT EXISTS backfill_id int8 NULL;
+
+CREATE INDEX IF NOT EXISTS jobs_backfill_id_state_idx ON call_audit.jobs (backfill_id, state) WHERE backfill_id IS NOT NULL;
@@ -0,0 +1,6 @@
+-- call_audit.call_questionnaire_rule call filter, the conditions of the stored calls audited by the rule
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS call_filter jsonb NULL;
+
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.call_filter IS 'CallFilter message, NULL matches any call';
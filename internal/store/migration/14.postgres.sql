@@ -0,0 +1,14 @@
+-- call_audit.call_questionnaire_rule sampling and quotas of the jobs created for the polled calls
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS sample_percent int4 NULL,
+	ADD COLUMN IF NOT EXISTS agent_daily_limit int4 NULL,
+	ADD COLUMN IF NOT EXISTS agent_weekly_limit int4 NULL,
+	ADD COLUMN IF NOT EXISTS daily_limit int4 NULL;
+
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.sample_percent IS 'percent of the matching calls audited, NULL audits all';
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.agent_daily_limit IS 'jobs per agent per day, NULL is no limit';
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.agent_weekly_limit IS 'jobs per agent per week, NULL is no limit';
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.daily_limit IS 'jobs of the rule per day, NULL is no limit';
+
+CREATE INDEX IF NOT EXISTS jobs_rule_id_created_at_idx ON call_audit.jobs (rule_id, created_at);
@@ -0,0 +1,6 @@
+-- call_audit.call_questionnaire_rule schedule, the cron spec the calls of the rule are audited at
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS schedule varchar NULL;
+
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.schedule IS 'cron spec in the domain timezone, the calls stored before its last run are audited; NULL audits every call as soon as it is stored';
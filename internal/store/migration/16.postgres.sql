@@ -0,0 +1,6 @@
+-- call_audit.call_questionnaire_rule priority, the share of the job workers the rule gets against the other rules of its domain
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS priority int4 DEFAULT 1 NOT NULL;
+
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.priority IS 'weight of the rule in the job scheduling of its domain, from 1 to 100';
@@ -0,0 +1,45 @@
+-- call_audit.llm_usage definition
+-- Tokens and cost of every chat completion of the audits and the previews.
+
+CREATE TABLE call_audit.llm_usage (
+	id bigserial NOT NULL,
+	domain_id int8 NOT NULL,
+	rule_id int8 NULL,
+	job_id int8 NULL,
+	provider varchar NULL,
+	model varchar NULL,
+	prompt_tokens int8 DEFAULT 0 NOT NULL,
+	completion_tokens int8 DEFAULT 0 NOT NULL,
+	cost numeric(16, 6) DEFAULT 0 NOT NULL,
+	created_at timestamptz DEFAULT now() NOT NULL,
+	CONSTRAINT llm_usage_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX llm_usage_domain_id_created_at_idx ON call_audit.llm_usage USING btree (domain_id, created_at);
+CREATE INDEX llm_usage_rule_id_created_at_idx ON call_audit.llm_usage USING btree (rule_id, created_at);
+
+COMMENT ON COLUMN call_audit.llm_usage.job_id IS 'NULL for a preview';
+COMMENT ON COLUMN call_audit.llm_usage.cost IS 'by the price table of the model when the completion was made, 0 for a model without a price';
+
+-- call_audit.budgets definition
+-- Monthly LLM budget of a domain, the rules of the domain stop creating jobs once it is spent.
+
+CREATE TABLE call_audit.budgets (
+	domain_id int8 NOT NULL,
+	monthly_limit numeric(16, 6) NOT NULL,
+	updated_at timestamptz DEFAULT now() NOT NULL,
+	updated_by int8 NULL,
+	CONSTRAINT budgets_pkey PRIMARY KEY (domain_id)
+);
+
+-- Permissions
+
+ALTER TABLE call_audit.llm_usage OWNER TO opensips;
+GRANT ALL ON TABLE call_audit.llm_usage TO opensips;
+ALTER TABLE call_audit.budgets OWNER TO opensips;
+GRANT ALL ON TABLE call_audit.budgets TO opensips;
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS paused_reason varchar NULL;
+
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.paused_reason IS 'why the enabled rule creates no jobs, set while the monthly budget of the domain is spent';
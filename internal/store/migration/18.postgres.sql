@@ -0,0 +1,6 @@
+-- call_audit.call_questionnaire_rule chunking, how a transcript too long for a single request is audited
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS chunking jsonb NULL;
+
+COMMENT ON COLUMN call_audit.call_questionnaire_rule.chunking IS 'TranscriptChunking in json with the proto field names, NULL splits the dialogues above 12000 tokens';
@@ -0,0 +1,13 @@
+-- call_audit.jobs de-duplication of the polled calls, the call event consumer, the reconcile poller
+-- and every replica enqueue the same call concurrently
+
+DELETE FROM call_audit.jobs j
+USING call_audit.jobs d
+WHERE j.rule_id = d.rule_id
+AND j.params->>'call_id' = d.params->>'call_id'
+AND j.backfill_id IS NULL AND j.priority = 0
+AND d.backfill_id IS NULL AND d.priority = 0
+AND j.id > d.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS jobs_rule_id_call_id_polled_uidx ON call_audit.jobs (rule_id, (params->>'call_id'))
+	WHERE backfill_id IS NULL AND priority = 0;
@@ -0,0 +1,9 @@
+-- call_audit.call_questionnaire_rule generation parameters
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN model varchar NULL,
+	ADD COLUMN temperature float8 NULL,
+	ADD COLUMN top_p float8 NULL,
+	ADD COLUMN max_output_tokens int4 NULL,
+	ADD COLUMN seed int8 NULL,
+	ADD COLUMN reasoning_effort varchar NULL;
@@ -0,0 +1,6 @@
+-- call_audit.jobs keeps the audit history, the succeeded jobs are counted by the quotas of the rules
+-- and the de-duplication of the calls, so the table survives a crash and every job is addressed by its id
+
+ALTER TABLE call_audit.jobs SET LOGGED;
+
+ALTER TABLE call_audit.jobs ADD CONSTRAINT jobs_pk PRIMARY KEY (id);
@@ -0,0 +1,7 @@
+-- call_audit.jobs completion tracking
+
+ALTER TABLE call_audit.jobs
+	ADD COLUMN updated_at timestamptz NULL,
+	ADD COLUMN error text NULL;
+
+COMMENT ON COLUMN call_audit.jobs.error IS 'failure reason of a job in state 4 (failed)';
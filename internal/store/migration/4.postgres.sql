@@ -0,0 +1,28 @@
+-- call_audit.scorecard_explanations definition
+
+CREATE TABLE call_audit.scorecard_explanations (
+	id bigserial NOT NULL,
+	job_id int8 NULL,
+	rule_id int8 NULL,
+	call_id varchar NOT NULL,
+	scorecard_id int4 NOT NULL,
+	rate_id int8 NULL,
+	question int4 NOT NULL,
+	question_text varchar NULL,
+	score int4 NULL,
+	rationale text NULL,
+	evidence jsonb DEFAULT '[]'::jsonb NOT NULL,
+	created_at timestamptz DEFAULT now() NOT NULL,
+	CONSTRAINT scorecard_explanations_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX scorecard_explanations_call_id_idx ON call_audit.scorecard_explanations USING btree (call_id);
+CREATE INDEX scorecard_explanations_rate_id_idx ON call_audit.scorecard_explanations USING btree (rate_id);
+
+COMMENT ON COLUMN call_audit.scorecard_explanations.question IS 'zero based index of the scorecard question';
+COMMENT ON COLUMN call_audit.scorecard_explanations.evidence IS 'verbatim quotes: [{phrase, quote, channel, start_sec, end_sec}]';
+
+-- Permissions
+
+ALTER TABLE call_audit.scorecard_explanations OWNER TO opensips;
+GRANT ALL ON TABLE call_audit.scorecard_explanations TO opensips;
@@ -0,0 +1,36 @@
+-- call_audit.results definition
+
+CREATE TABLE call_audit.results (
+	id bigserial NOT NULL,
+	domain_id int8 NOT NULL,
+	created_at timestamptz DEFAULT now() NOT NULL,
+	job_id int8 NULL,
+	rule_id int8 NULL,
+	call_id varchar NOT NULL,
+	scorecard_id int4 NULL,
+	rate_id int8 NULL,
+	provider varchar NULL,
+	model varchar NULL,
+	prompt_version varchar NULL,
+	raw_response text NULL,
+	scores jsonb NULL,
+	score int4 NULL,
+	summary text NULL,
+	category varchar NULL,
+	prompt_tokens int8 DEFAULT 0 NOT NULL,
+	completion_tokens int8 DEFAULT 0 NOT NULL,
+	latency_ms int8 DEFAULT 0 NOT NULL,
+	CONSTRAINT results_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX results_domain_id_created_at_idx ON call_audit.results USING btree (domain_id, created_at DESC);
+CREATE INDEX results_call_id_idx ON call_audit.results USING btree (call_id);
+CREATE INDEX results_rule_id_idx ON call_audit.results USING btree (rule_id);
+
+COMMENT ON COLUMN call_audit.results.scores IS 'scorecard answers: [{question, score}]';
+COMMENT ON COLUMN call_audit.results.score IS 'sum of the answered scores';
+
+-- Permissions
+
+ALTER TABLE call_audit.results OWNER TO opensips;
+GRANT ALL ON TABLE call_audit.results TO opensips;
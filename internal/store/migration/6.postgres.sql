@@ -0,0 +1,18 @@
+-- call_audit.call_questionnaire_rule column fixes
+
+-- the initial definition created the cognitive profile column with a cyrillic "с"
+DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1
+		FROM information_schema.columns
+		WHERE table_schema = 'call_audit'
+		AND table_name = 'call_questionnaire_rule'
+		AND column_name = 'сognitive_profile'
+	) THEN
+		ALTER TABLE call_audit.call_questionnaire_rule RENAME COLUMN "сognitive_profile" TO cognitive_profile;
+	END IF;
+END $$;
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS scorecard int4 NULL;
@@ -0,0 +1,7 @@
+-- row versions for optimistic concurrency (etag = id + ver)
+
+ALTER TABLE call_audit.call_questionnaire_rule
+	ADD COLUMN IF NOT EXISTS ver int4 DEFAULT 0 NOT NULL;
+
+ALTER TABLE "storage".language_profiles
+	ADD COLUMN IF NOT EXISTS ver int4 DEFAULT 0 NOT NULL;
@@ -0,0 +1,10 @@
+-- call_audit.jobs leases, a job in state 1 belongs to locked_by until lease_until
+
+ALTER TABLE call_audit.jobs
+	ADD COLUMN IF NOT EXISTS locked_by varchar NULL,
+	ADD COLUMN IF NOT EXISTS lease_until timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS jobs_state_lease_until_idx ON call_audit.jobs (state, lease_until);
+
+COMMENT ON COLUMN call_audit.jobs.locked_by IS 'instance processing the job';
+COMMENT ON COLUMN call_audit.jobs.lease_until IS 'an active job with an expired lease is claimed again';
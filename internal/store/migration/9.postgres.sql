@@ -0,0 +1,12 @@
+-- call_audit.jobs retries, a job in state 5 (retry_scheduled) is claimed again after next_run_at,
+-- states 4 (failed) and 6 (dead) are final
+
+ALTER TABLE call_audit.jobs
+	ADD COLUMN IF NOT EXISTS attempts int4 DEFAULT 0 NOT NULL,
+	ADD COLUMN IF NOT EXISTS next_run_at timestamptz NULL;
+
+CREATE INDEX IF NOT EXISTS jobs_state_next_run_at_idx ON call_audit.jobs (state, next_run_at);
+
+COMMENT ON COLUMN call_audit.jobs.attempts IS 'number of times the job has been claimed';
+COMMENT ON COLUMN call_audit.jobs.next_run_at IS 'when a job scheduled for retry becomes claimable';
+COMMENT ON COLUMN call_audit.jobs.error IS 'last failure reason';
@@ -0,0 +1,305 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	_go "github.com/webitel/call_audit/api/call_audit"
+	dberr "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/postgres/scanner"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model/options"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type AuditResultScan func(result *_go.AuditResult) any
+
+const (
+	arLeft                 = "ar"
+	auditResultDefaultSort = "created_at"
+)
+
+// auditResultSortColumns maps the sortable fields to their columns.
+var auditResultSortColumns = map[string]string{
+	"id":             util.Ident(arLeft, "id"),
+	"created_at":     util.Ident(arLeft, "created_at"),
+	"call_id":        util.Ident(arLeft, "call_id"),
+	"rule":           util.Ident(arLeft, "rule_id"),
+	"model":          util.Ident(arLeft, "model"),
+	"prompt_version": util.Ident(arLeft, "prompt_version"),
+	"score":          util.Ident(arLeft, "score"),
+	"category":       util.Ident(arLeft, "category"),
+	"latency_ms":     util.Ident(arLeft, "latency_ms"),
+}
+
+// AuditResultStore provides methods to query the audit results in the database.
+type AuditResultStore struct {
+	storage *Store
+}
+
+// NewAuditResultStore creates a new AuditResultStore.
+func NewAuditResultStore(storage *Store) *AuditResultStore {
+	return &AuditResultStore{storage: storage}
+}
+
+// Search implements store.AuditResultStore.
+func (s *AuditResultStore) Search(rpc options.SearchOptions) ([]*_go.AuditResult, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.audit_result.search.database_connection_error", dbErr)
+	}
+
+	query, plan, err := s.buildSearchAuditResultQuery(rpc)
+	if err != nil {
+		return nil, err
+	}
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.audit_result.search.query_build_error", err)
+	}
+
+	rows, err := db.Query(rpc, sql, args...)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.audit_result.search.execution_error", err)
+	}
+	defer rows.Close()
+
+	var items []*_go.AuditResult
+	for rows.Next() {
+		item := &_go.AuditResult{}
+		scanArgs := make([]any, 0, len(plan))
+		for _, scan := range plan {
+			scanArgs = append(scanArgs, scan(item))
+		}
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, dberr.NewDBInternalError("postgres.audit_result.search.scan_error", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.audit_result.search.rows_error", err)
+	}
+	return items, nil
+}
+
+func (s *AuditResultStore) buildSearchAuditResultQuery(rpc options.SearchOptions) (sq.SelectBuilder, []AuditResultScan, error) {
+	queryBuilder := sq.Select().
+		From("call_audit.results AS ar").
+		Where(sq.Eq{"ar.domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+
+	if len(rpc.GetIDs()) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"ar.id": rpc.GetIDs()})
+	}
+	if q := rpc.GetSearch(); q != "" {
+		queryBuilder = util.AddSearchTerm(queryBuilder, q, util.Ident(arLeft, "summary"))
+	}
+
+	// -------- Apply filters ----------
+	if v, ok := rpc.GetFilter("call_id").(string); ok && v != "" {
+		queryBuilder = queryBuilder.Where(sq.Eq{"ar.call_id": v})
+	}
+	if v, ok := rpc.GetFilter("rule_id").([]int64); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"ar.rule_id": v})
+	}
+	if v, ok := rpc.GetFilter("created_from").(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"ar.created_at": v})
+	}
+	if v, ok := rpc.GetFilter("created_to").(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"ar.created_at": v})
+	}
+	if v, ok := rpc.GetFilter("category").([]string); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"ar.category": v})
+	}
+	if v, ok := rpc.GetFilter("score_from").(int32); ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"ar.score": v})
+	}
+	if v, ok := rpc.GetFilter("score_to").(int32); ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"ar.score": v})
+	}
+
+	// -------- Apply sorting ----------
+	field, direction := util.GetSortingOperator(rpc.GetSort())
+	column, ok := auditResultSortColumns[field]
+	if !ok {
+		column, direction = auditResultSortColumns[auditResultDefaultSort], util.SortDesc
+	}
+	queryBuilder = queryBuilder.OrderBy(fmt.Sprintf("%s %s", column, direction))
+
+	// ---------Apply paging based on Search Opts ( page ; size ) -----------------
+	queryBuilder = util.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
+
+	// Add select columns and scan plan for requested fields
+	queryBuilder, plan, err := buildAuditResultSelectColumnsAndPlan(queryBuilder, rpc.GetFields())
+	if err != nil {
+		return sq.SelectBuilder{}, nil, dberr.NewDBInternalError("postgres.audit_result.search.query_build_error", err)
+	}
+	return queryBuilder, plan, nil
+}
+
+func buildAuditResultSelectColumnsAndPlan(
+	base sq.SelectBuilder,
+	fields []string,
+) (sq.SelectBuilder, []AuditResultScan, error) {
+	var plan []AuditResultScan
+	for _, field := range fields {
+		switch field {
+		case "id":
+			base = base.Column(util.Ident(arLeft, "id"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return &res.Id
+			})
+		case "domain_id":
+			base = base.Column(util.Ident(arLeft, "domain_id"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return &res.DomainId
+			})
+		case "created_at":
+			base = base.Column(util.Ident(arLeft, "created_at"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanProtoTimestamp(&res.CreatedAt)
+			})
+		case "job_id":
+			base = base.Column(util.Ident(arLeft, "job_id"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanInt64(&res.JobId)
+			})
+		case "rule":
+			base = base.Column(util.Ident(arLeft, "rule_id")).
+				Column("(SELECT r.name FROM call_audit.call_questionnaire_rule r WHERE r.id = ar.rule_id) AS rule_name")
+			plan = append(plan,
+				func(res *_go.AuditResult) any {
+					res.Rule = &_go.Lookup{}
+					return scanner.ScanInt64(&res.Rule.Id)
+				},
+				func(res *_go.AuditResult) any {
+					return scanner.ScanText(&res.Rule.Name)
+				},
+			)
+		case "call_id":
+			base = base.Column(util.Ident(arLeft, "call_id"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return &res.CallId
+			})
+		case "scorecard_id":
+			base = base.Column(util.Ident(arLeft, "scorecard_id"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanFunc(func(src any) error {
+					var id int64
+					if err := scanner.ScanInt64(&id).(scanner.ScanFunc)(src); err != nil {
+						return err
+					}
+					res.ScorecardId = int32(id)
+					return nil
+				})
+			})
+		case "rate_id":
+			base = base.Column(util.Ident(arLeft, "rate_id"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanInt64(&res.RateId)
+			})
+		case "provider":
+			base = base.Column(util.Ident(arLeft, "provider"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanText(&res.Provider)
+			})
+		case "model":
+			base = base.Column(util.Ident(arLeft, "model"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanText(&res.Model)
+			})
+		case "prompt_version":
+			base = base.Column(util.Ident(arLeft, "prompt_version"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanText(&res.PromptVersion)
+			})
+		case "raw_response":
+			base = base.Column(util.Ident(arLeft, "raw_response"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanText(&res.RawResponse)
+			})
+		case "scores":
+			base = base.Column(util.Ident(arLeft, "scores"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanAuditScores(&res.Scores)
+			})
+		case "score":
+			base = base.Column(util.Ident(arLeft, "score"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanInt32Value(&res.Score)
+			})
+		case "summary":
+			base = base.Column(util.Ident(arLeft, "summary"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanText(&res.Summary)
+			})
+		case "category":
+			base = base.Column(util.Ident(arLeft, "category"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return scanner.ScanText(&res.Category)
+			})
+		case "usage":
+			base = base.Column(util.Ident(arLeft, "prompt_tokens")).
+				Column(util.Ident(arLeft, "completion_tokens"))
+			plan = append(plan,
+				func(res *_go.AuditResult) any {
+					res.Usage = &_go.AuditUsage{}
+					return &res.Usage.PromptTokens
+				},
+				func(res *_go.AuditResult) any {
+					return scanner.ScanFunc(func(src any) error {
+						if err := scanner.ScanInt64(&res.Usage.CompletionTokens).(scanner.ScanFunc)(src); err != nil {
+							return err
+						}
+						res.Usage.TotalTokens = res.Usage.PromptTokens + res.Usage.CompletionTokens
+						return nil
+					})
+				},
+			)
+		case "latency_ms":
+			base = base.Column(util.Ident(arLeft, "latency_ms"))
+			plan = append(plan, func(res *_go.AuditResult) any {
+				return &res.LatencyMs
+			})
+		default:
+			return base, nil, dberr.NewDBInternalError("postgres.audit_result.unknown_field", fmt.Errorf("unknown field: %s", field))
+		}
+	}
+	return base, plan, nil
+}
+
+// scanAuditScores decodes the scores jsonb column, NULL leaves no scores.
+func scanAuditScores(ref *[]*_go.AuditScore) any {
+	return scanner.ScanFunc(func(src any) error {
+		var raw []byte
+		switch v := src.(type) {
+		case nil:
+			return nil
+		case string:
+			raw = []byte(v)
+		case []byte:
+			raw = v
+		default:
+			return fmt.Errorf("scores: cannot scan %T", src)
+		}
+		var scores []struct {
+			Question int32  `json:"question"`
+			Score    *int32 `json:"score"`
+		}
+		if err := json.Unmarshal(raw, &scores); err != nil {
+			return err
+		}
+		out := make([]*_go.AuditScore, 0, len(scores))
+		for _, s := range scores {
+			item := &_go.AuditScore{Question: s.Question}
+			if s.Score != nil {
+				item.Score = wrapperspb.Int32(*s.Score)
+			}
+			out = append(out, item)
+		}
+		*ref = out
+		return nil
+	})
+}
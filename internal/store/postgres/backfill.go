@@ -0,0 +1,351 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_go "github.com/webitel/call_audit/api/call_audit"
+	dberr "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/postgres/scanner"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
+	"github.com/webitel/call_audit/model/options"
+)
+
+type BackfillScan func(backfill *_go.Backfill) any
+
+const (
+	backfillLeft        = "b"
+	backfillDefaultSort = "created_at"
+)
+
+// backfillSortColumns maps the sortable fields to their columns.
+var backfillSortColumns = map[string]string{
+	"id":         util.Ident(backfillLeft, "id"),
+	"rule":       util.Ident(backfillLeft, "rule_id"),
+	"state":      util.Ident(backfillLeft, "state"),
+	"created_at": util.Ident(backfillLeft, "created_at"),
+	"updated_at": util.Ident(backfillLeft, "updated_at"),
+}
+
+// BackfillStore provides methods to manage the rule backfills in the database.
+type BackfillStore struct {
+	storage *Store
+}
+
+// NewBackfillStore creates a new BackfillStore.
+func NewBackfillStore(storage *Store) *BackfillStore {
+	return &BackfillStore{storage: storage}
+}
+
+// Create implements store.BackfillStore.
+func (s *BackfillStore) Create(rpc options.CreateOptions, backfill *_go.Backfill) (*_go.Backfill, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.create.database_connection_error", dbErr)
+	}
+
+	insert := sq.Insert("call_audit.backfills").
+		SetMap(map[string]any{
+			"domain_id":  rpc.GetAuthOpts().GetDomainId(),
+			"rule_id":    backfill.GetRule().GetId(),
+			"from":       backfill.GetFrom().AsTime(),
+			"to":         backfill.GetTo().AsTime(),
+			"batch_size": backfill.GetBatchSize(),
+			"total":      backfill.GetTotal(),
+			"cursor_at":  backfill.GetFrom().AsTime(),
+			"created_at": rpc.RequestTime(),
+			"created_by": rpc.GetAuthOpts().GetUserId(),
+			"updated_at": rpc.RequestTime(),
+			"updated_by": rpc.GetAuthOpts().GetUserId(),
+		}).
+		Suffix("RETURNING *")
+
+	res, err := s.scanOne(rpc, db, insert, rpc.GetFields())
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.create.execution_error", err)
+	}
+	return res, nil
+}
+
+// Search implements store.BackfillStore.
+func (s *BackfillStore) Search(rpc options.SearchOptions) ([]*_go.Backfill, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.search.database_connection_error", dbErr)
+	}
+
+	queryBuilder := sq.Select().
+		From("call_audit.backfills AS b").
+		Where(sq.Eq{"b.domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+	if len(rpc.GetIDs()) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"b.id": rpc.GetIDs()})
+	}
+	if v, ok := rpc.GetFilter("rule_id").([]int64); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"b.rule_id": v})
+	}
+	if v, ok := rpc.GetFilter("state").([]model.BackfillState); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"b.state": v})
+	}
+
+	field, direction := util.GetSortingOperator(rpc.GetSort())
+	column, ok := backfillSortColumns[field]
+	if !ok {
+		column, direction = backfillSortColumns[backfillDefaultSort], util.SortDesc
+	}
+	queryBuilder = queryBuilder.OrderBy(fmt.Sprintf("%s %s", column, direction))
+	queryBuilder = util.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
+
+	queryBuilder, plan, err := buildBackfillSelectColumnsAndPlan(queryBuilder, rpc.GetFields())
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.search.query_build_error", err)
+	}
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.search.query_build_error", err)
+	}
+
+	rows, err := db.Query(rpc, query, args...)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.search.execution_error", err)
+	}
+	defer rows.Close()
+
+	var items []*_go.Backfill
+	for rows.Next() {
+		item := &_go.Backfill{}
+		if err := rows.Scan(backfillScanArgs(item, plan)...); err != nil {
+			return nil, dberr.NewDBInternalError("postgres.backfill.search.scan_error", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill.search.rows_error", err)
+	}
+	return items, nil
+}
+
+// Pause implements store.BackfillStore.
+func (s *BackfillStore) Pause(rpc options.UpdateOptions) (*_go.Backfill, error) {
+	return s.setState(rpc, "pause", model.BackfillStatePaused, model.BackfillStateRunning)
+}
+
+// Resume implements store.BackfillStore.
+func (s *BackfillStore) Resume(rpc options.UpdateOptions) (*_go.Backfill, error) {
+	return s.setState(rpc, "resume", model.BackfillStateRunning, model.BackfillStatePaused)
+}
+
+// Cancel implements store.BackfillStore.
+// The waiting jobs of the backfill are cancelled too, the running ones are finished.
+func (s *BackfillStore) Cancel(rpc options.UpdateOptions) (*_go.Backfill, error) {
+	return s.setState(rpc, "cancel", model.BackfillStateCancelled, model.BackfillStateRunning, model.BackfillStatePaused)
+}
+
+// setState moves the backfill of rpc.GetIDs() from one of the from states to the state.
+// No updated row is a conflict when the backfill exists in another state.
+func (s *BackfillStore) setState(rpc options.UpdateOptions, action string, state model.BackfillState, from ...model.BackfillState) (*_go.Backfill, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill."+action+".database_connection_error", dbErr)
+	}
+	domainID := rpc.GetAuthOpts().GetDomainId()
+
+	update := sq.Update("call_audit.backfills").
+		Set("state", state).
+		Set("updated_at", rpc.RequestTime()).
+		Set("updated_by", rpc.GetAuthOpts().GetUserId()).
+		Where(sq.Eq{"id": rpc.GetIDs(), "domain_id": domainID, "state": from}).
+		Suffix("RETURNING *")
+	res, err := s.scanOne(rpc, db, update, rpc.GetFields())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, s.wrongStateOrMissing(rpc, db, domainID, action, rpc.GetIDs())
+	}
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.backfill."+action+".execution_error", err)
+	}
+
+	if state == model.BackfillStateCancelled {
+		query, args, err := sq.Update("call_audit.jobs").
+			Set("state", model.JobStateCancelled).
+			Set("next_run_at", nil).
+			Set("updated_at", rpc.RequestTime()).
+			Where(sq.Eq{"backfill_id": rpc.GetIDs(), "state": []model.JobState{model.JobStatePending, model.JobStateRetryScheduled}}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return nil, dberr.NewDBInternalError("postgres.backfill.cancel.query_build_error", err)
+		}
+		if _, err := db.Exec(rpc, query, args...); err != nil {
+			return nil, dberr.NewDBInternalError("postgres.backfill.cancel.execution_error", err)
+		}
+	}
+	return res, nil
+}
+
+func (s *BackfillStore) wrongStateOrMissing(ctx context.Context, db *pgxpool.Pool, domainID int64, action string, ids []int64) error {
+	query, args, err := sq.Select("count(*)").
+		From("call_audit.backfills").
+		Where(sq.Eq{"id": ids, "domain_id": domainID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.backfill."+action+".query_build_error", err)
+	}
+	var count int64
+	if err := db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return dberr.NewDBInternalError("postgres.backfill."+action+".execution_error", err)
+	}
+	if count == 0 {
+		return dberr.NewDBNoRowsError("postgres.backfill." + action + ".not_found")
+	}
+	return dberr.NewDBConflictError("postgres.backfill."+action+".conflict", "backfill state does not allow to "+action+" it")
+}
+
+// scanOne runs the data modifying statement returning a backfill row and selects the fields of it.
+func (s *BackfillStore) scanOne(ctx context.Context, db *pgxpool.Pool, statement sq.Sqlizer, fields []string) (*_go.Backfill, error) {
+	cte, args, err := statement.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	queryBuilder, plan, err := buildBackfillSelectColumnsAndPlan(
+		sq.Select().Prefix("WITH b AS ("+cte+")", args...).From("b").PlaceholderFormat(sq.Dollar),
+		fields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	backfill := &_go.Backfill{}
+	if err := db.QueryRow(ctx, query, args...).Scan(backfillScanArgs(backfill, plan)...); err != nil {
+		return nil, err
+	}
+	return backfill, nil
+}
+
+func backfillScanArgs(backfill *_go.Backfill, plan []BackfillScan) []any {
+	args := make([]any, 0, len(plan))
+	for _, scan := range plan {
+		args = append(args, scan(backfill))
+	}
+	return args
+}
+
+func buildBackfillSelectColumnsAndPlan(
+	base sq.SelectBuilder,
+	fields []string,
+) (sq.SelectBuilder, []BackfillScan, error) {
+	var plan []BackfillScan
+	// jobs counts the jobs of the backfill in the states
+	jobs := func(field string, states ...model.JobState) {
+		base = base.Column(sq.Alias(sq.Expr(
+			"(SELECT count(*) FROM call_audit.jobs j WHERE j.backfill_id = b.id AND j.state = ANY(?::int4[]))", states,
+		), field))
+	}
+	for _, field := range fields {
+		switch field {
+		case "id":
+			base = base.Column(util.Ident(backfillLeft, "id"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.Id
+			})
+		case "rule":
+			base = base.Column(util.Ident(backfillLeft, "rule_id")).
+				Column("(SELECT r.name FROM call_audit.call_questionnaire_rule r WHERE r.id = b.rule_id) AS rule_name")
+			plan = append(plan,
+				func(backfill *_go.Backfill) any {
+					backfill.Rule = &_go.Lookup{}
+					return scanner.ScanInt64(&backfill.Rule.Id)
+				},
+				func(backfill *_go.Backfill) any {
+					return scanner.ScanText(&backfill.Rule.Name)
+				},
+			)
+		case "from":
+			base = base.Column(util.Ident(backfillLeft, "from"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return scanner.ScanProtoTimestamp(&backfill.From)
+			})
+		case "to":
+			base = base.Column(util.Ident(backfillLeft, "to"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return scanner.ScanProtoTimestamp(&backfill.To)
+			})
+		case "state":
+			base = base.Column(util.Ident(backfillLeft, "state"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return scanner.ScanFunc(func(src any) error {
+					var state int32
+					if err := scanner.ScanInt32(&state).(scanner.ScanFunc)(src); err != nil {
+						return err
+					}
+					backfill.State = _go.BackfillState(state)
+					return nil
+				})
+			})
+		case "batch_size":
+			base = base.Column(util.Ident(backfillLeft, "batch_size"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.BatchSize
+			})
+		case "total":
+			base = base.Column(util.Ident(backfillLeft, "total"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.Total
+			})
+		case "queued":
+			base = base.Column(util.Ident(backfillLeft, "queued"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.Queued
+			})
+		case "done":
+			base = base.Column(util.Ident(backfillLeft, "done"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.Done
+			})
+		case "failed":
+			jobs("failed", model.JobStateFailed, model.JobStateDead)
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.Failed
+			})
+		case "pending":
+			jobs("pending", model.JobStatePending, model.JobStateRunning, model.JobStateRetryScheduled)
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return &backfill.Pending
+			})
+		case "created_at":
+			base = base.Column(util.Ident(backfillLeft, "created_at"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return scanner.ScanProtoTimestamp(&backfill.CreatedAt)
+			})
+		case "created_by":
+			base = base.Column(util.Ident(backfillLeft, "created_by")).
+				Column("(SELECT coalesce(u.name, u.username) FROM directory.wbt_user u WHERE u.id = b.created_by) AS created_by_name")
+			plan = append(plan,
+				func(backfill *_go.Backfill) any {
+					backfill.CreatedBy = &_go.Lookup{}
+					return scanner.ScanInt64(&backfill.CreatedBy.Id)
+				},
+				func(backfill *_go.Backfill) any {
+					return scanner.ScanText(&backfill.CreatedBy.Name)
+				},
+			)
+		case "updated_at":
+			base = base.Column(util.Ident(backfillLeft, "updated_at"))
+			plan = append(plan, func(backfill *_go.Backfill) any {
+				return scanner.ScanProtoTimestamp(&backfill.UpdatedAt)
+			})
+		default:
+			return base, nil, fmt.Errorf("unknown field: %s", field)
+		}
+	}
+	return base, plan, nil
+}
@@ -2,13 +2,24 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	cr "github.com/webitel/call_audit/api/call_audit"
 	dberr "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/postgres/scanner"
 	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
 	options "github.com/webitel/call_audit/model/options"
+	util2 "github.com/webitel/call_audit/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type QuestionnaireRuleScan func(rule *cr.CallQuestionnaireRule) any
@@ -18,39 +29,212 @@ const (
 	closeQuestionnaireRuleDefaultSort = "name"
 )
 
+// questionnaireRuleFilterColumns maps the supported list filters to their columns.
+var questionnaireRuleFilterColumns = map[string]string{
+	"enabled":           util.Ident(cqrLeft, "enabled"),
+	"call_direction":    util.Ident(cqrLeft, "call_direction"),
+	"language_profile":  util.Ident(cqrLeft, "language_profile"),
+	"cognitive_profile": util.Ident(cqrLeft, "cognitive_profile"),
+	"scorecard":         util.Ident(cqrLeft, "scorecard"),
+}
+
+// questionnaireRuleSortColumns maps the sortable fields to their columns.
+var questionnaireRuleSortColumns = map[string]string{
+	"id":             util.Ident(cqrLeft, "id"),
+	"name":           util.Ident(cqrLeft, "name"),
+	"created_at":     util.Ident(cqrLeft, "created_at"),
+	"updated_at":     util.Ident(cqrLeft, "updated_at"),
+	"enabled":        util.Ident(cqrLeft, "enabled"),
+	"from":           util.Ident(cqrLeft, `"from"`),
+	"to":             util.Ident(cqrLeft, `"to"`),
+	"last_stored_at": util.Ident(cqrLeft, "last_stored_at"),
+}
+
 // CallQuestionnaireRule provides methods to interact with call questionnaire rules in the database.
 type CallQuestionnaireRuleStore struct {
 	storage *Store
 }
 
 // Create implements store.CallQuestionnaireRuleStore.
-func (c *CallQuestionnaireRuleStore) Create(ctx context.Context, rule *cr.CallQuestionnaireRule) (*cr.CallQuestionnaireRule, error) {
-	panic("unimplemented")
+func (c *CallQuestionnaireRuleStore) Create(rpc options.CreateOptions, rule *cr.CallQuestionnaireRule) (*cr.CallQuestionnaireRule, error) {
+	db, dbErr := c.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.create.database_connection_error", dbErr)
+	}
+
+	insert := sq.Insert("call_audit.call_questionnaire_rule").
+		SetMap(questionnaireRuleValues(rule)).
+		SetMap(map[string]any{
+			"domain_id":  rpc.GetAuthOpts().GetDomainId(),
+			"created_at": rpc.RequestTime(),
+			"created_by": rpc.GetAuthOpts().GetUserId(),
+			"updated_at": rpc.RequestTime(),
+			"updated_by": rpc.GetAuthOpts().GetUserId(),
+		}).
+		Suffix("RETURNING *")
+
+	res, err := c.scanOne(rpc, db, insert, rpc.GetFields())
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.create.execution_error", err)
+	}
+	return res, nil
 }
 
 // Delete implements store.CallQuestionnaireRuleStore.
-func (c *CallQuestionnaireRuleStore) Delete(ctx context.Context, id int64) error {
-	panic("unimplemented")
+func (c *CallQuestionnaireRuleStore) Delete(rpc options.DeleteOptions) error {
+	db, dbErr := c.storage.Database()
+	if dbErr != nil {
+		return dberr.NewDBInternalError("postgres.call_questionnaire_rule.delete.database_connection_error", dbErr)
+	}
+
+	deleteBuilder := sq.Delete("call_audit.call_questionnaire_rule").
+		Where(sq.Eq{"id": rpc.GetIDs(), "domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+	ver, versioned := rpc.GetFilter("ver").(int32)
+	if versioned {
+		deleteBuilder = deleteBuilder.Where(sq.Eq{"ver": ver})
+	}
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.call_questionnaire_rule.delete.query_build_error", err)
+	}
+
+	res, err := db.Exec(rpc, query, args...)
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.call_questionnaire_rule.delete.execution_error", err)
+	}
+	if res.RowsAffected() == 0 {
+		if versioned {
+			return c.staleOrMissing(rpc, db, rpc.GetAuthOpts().GetDomainId(), "delete", rpc.GetIDs())
+		}
+		return dberr.NewDBNoRowsError("postgres.call_questionnaire_rule.delete.not_found")
+	}
+	return nil
 }
 
 // Get implements store.CallQuestionnaireRuleStore.
-func (c *CallQuestionnaireRuleStore) Get(ctx context.Context, id int64) (*cr.CallQuestionnaireRule, error) {
-	panic("unimplemented")
+func (c *CallQuestionnaireRuleStore) Get(rpc options.SearchOptions) (*cr.CallQuestionnaireRule, error) {
+	list, err := c.List(rpc)
+	if err != nil {
+		return nil, err
+	}
+	if len(list.GetItems()) == 0 {
+		return nil, dberr.NewDBNoRowsError("postgres.call_questionnaire_rule.get.not_found")
+	}
+	return list.GetItems()[0], nil
 }
 
 // List implements store.CallQuestionnaireRuleStore.
-func (c *CallQuestionnaireRuleStore) List(ctx context.Context) (*cr.CallQuestionnaireRuleList, error) {
-	_, dbErr := c.storage.Database()
+func (c *CallQuestionnaireRuleStore) List(rpc options.SearchOptions) (*cr.CallQuestionnaireRuleList, error) {
+	db, dbErr := c.storage.Database()
 	if dbErr != nil {
 		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.list.database_connection_error", dbErr)
 	}
 
-	return &cr.CallQuestionnaireRuleList{}, nil
+	queryBuilder, plan, err := c.buildListCallQuestionnaireRuleQuery(rpc, 0)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.list.query_build_error", err)
+	}
+
+	rows, err := db.Query(rpc, query, args...)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.list.execution_error", err)
+	}
+	defer rows.Close()
+
+	var items []*cr.CallQuestionnaireRule
+	for rows.Next() {
+		rule := &cr.CallQuestionnaireRule{}
+		if err := rows.Scan(questionnaireRuleScanArgs(rule, plan)...); err != nil {
+			return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.list.scan_error", err)
+		}
+		setQuestionnaireRuleEtag(rule, rpc.GetFields())
+		items = append(items, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.list.rows_error", err)
+	}
+
+	return &cr.CallQuestionnaireRuleList{
+		Items: items,
+		Page:  int32(rpc.GetPage()),
+	}, nil
 }
 
 // Update implements store.CallQuestionnaireRuleStore.
-func (c *CallQuestionnaireRuleStore) Update(ctx context.Context, rule *cr.CallQuestionnaireRule) (*cr.CallQuestionnaireRule, error) {
-	panic("unimplemented")
+// With a mask only the listed fields are changed, otherwise the rule is replaced.
+// When the rule carries an etag, its Ver is the version the caller has read and a changed row is a conflict.
+func (c *CallQuestionnaireRuleStore) Update(rpc options.UpdateOptions, rule *cr.CallQuestionnaireRule) (*cr.CallQuestionnaireRule, error) {
+	db, dbErr := c.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.update.database_connection_error", dbErr)
+	}
+
+	values := questionnaireRuleValues(rule)
+	if mask := rpc.GetMask(); len(mask) > 0 {
+		masked := make(map[string]any, len(mask))
+		for _, field := range mask {
+			if field == "id" || field == "ver" || field == "etag" {
+				continue
+			}
+			column := questionnaireRuleColumn(field)
+			value, ok := values[column]
+			if !ok {
+				return nil, dberr.NewDBBadRequestError("postgres.call_questionnaire_rule.update.unknown_mask_field", field)
+			}
+			masked[column] = value
+		}
+		values = masked
+	}
+
+	versioned := rule.GetEtag() != ""
+	update := sq.Update("call_audit.call_questionnaire_rule").
+		SetMap(values).
+		Set("updated_at", rpc.RequestTime()).
+		Set("updated_by", rpc.GetAuthOpts().GetUserId()).
+		Set("ver", sq.Expr("ver + 1")).
+		Where(sq.Eq{"id": rule.GetId(), "domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		Suffix("RETURNING *")
+	if versioned {
+		update = update.Where(sq.Eq{"ver": rule.GetVer()})
+	}
+
+	res, err := c.scanOne(rpc, db, update, rpc.GetFields())
+	if errors.Is(err, pgx.ErrNoRows) {
+		if versioned {
+			return nil, c.staleOrMissing(rpc, db, rpc.GetAuthOpts().GetDomainId(), "update", []int64{int64(rule.GetId())})
+		}
+		return nil, dberr.NewDBNoRowsError("postgres.call_questionnaire_rule.update.not_found")
+	}
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.update.execution_error", err)
+	}
+	return res, nil
+}
+
+// staleOrMissing tells a rule changed since the supplied etag apart from a missing one
+// after a versioned statement affected no rows.
+func (c *CallQuestionnaireRuleStore) staleOrMissing(ctx context.Context, db *pgxpool.Pool, domainID int64, action string, ids []int64) error {
+	query, args, err := sq.Select("count(*)").
+		From("call_audit.call_questionnaire_rule").
+		Where(sq.Eq{"id": ids, "domain_id": domainID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.call_questionnaire_rule."+action+".query_build_error", err)
+	}
+	var count int64
+	if err := db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return dberr.NewDBInternalError("postgres.call_questionnaire_rule."+action+".execution_error", err)
+	}
+	if count == 0 {
+		return dberr.NewDBNoRowsError("postgres.call_questionnaire_rule." + action + ".not_found")
+	}
+	return dberr.NewDBConflictError("postgres.call_questionnaire_rule."+action+".conflict", "rule has been changed, reload it and try again")
 }
 
 // NewCallQuestionnaireRuleStore creates a new CallQuestionnaireRuleStore.
@@ -58,22 +242,165 @@ func NewCallQuestionnaireRuleStore(storage *Store) *CallQuestionnaireRuleStore {
 	return &CallQuestionnaireRuleStore{storage: storage}
 }
 
+// scanOne runs the modifying statement as the "cqr" CTE and selects the requested fields of the affected row.
+func (c *CallQuestionnaireRuleStore) scanOne(ctx context.Context, db *pgxpool.Pool, statement sq.Sqlizer, fields []string) (*cr.CallQuestionnaireRule, error) {
+	cte, args, err := statement.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	queryBuilder, plan, err := buildQuestionnaireRuleSelectColumnsAndPlan(
+		sq.Select().Prefix("WITH cqr AS ("+cte+")", args...).From("cqr").PlaceholderFormat(sq.Dollar),
+		fields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &cr.CallQuestionnaireRule{}
+	if err := db.QueryRow(ctx, query, args...).Scan(questionnaireRuleScanArgs(rule, plan)...); err != nil {
+		return nil, err
+	}
+	setQuestionnaireRuleEtag(rule, fields)
+	return rule, nil
+}
+
+// questionnaireRuleValues maps the editable rule fields to their columns.
+func questionnaireRuleValues(rule *cr.CallQuestionnaireRule) map[string]any {
+	return map[string]any{
+		"name":               rule.GetName(),
+		"description":        util.StrPtrOrNil(rule.GetDescription()),
+		"enabled":            rule.GetEnabled(),
+		"language_profile":   lookupIDOrNil(rule.GetLanguageProfile()),
+		"cognitive_profile":  lookupIDOrNil(rule.GetCognitiveProfile()),
+		`"from"`:             timeOrNil(rule.GetFrom()),
+		`"to"`:               timeOrNil(rule.GetTo()),
+		"call_direction":     util.StrPtrOrNil(rule.GetCallDirection()),
+		"min_call_duration":  rule.GetMinCallDuration(),
+		"variable":           util.StrPtrOrNil(rule.GetVariable()),
+		"default_promt":      util.StrPtrOrNil(rule.GetDefaultPromt()),
+		"save_explanation":   rule.GetSaveExplanation(),
+		"scorecard":          lookupIDOrNil(rule.GetScorecard()),
+		"model":              util.StrPtrOrNil(rule.GetModel()),
+		"temperature":        wrapperOrNil(rule.GetTemperature()),
+		"top_p":              wrapperOrNil(rule.GetTopP()),
+		"max_output_tokens":  wrapperOrNil(rule.GetMaxOutputTokens()),
+		"seed":               wrapperOrNil(rule.GetSeed()),
+		"reasoning_effort":   util.StrPtrOrNil(rule.GetReasoningEffort()),
+		"call_filter":        jsonOrNil(rule.GetCallFilter()),
+		"sample_percent":     wrapperOrNil(rule.GetSamplePercent()),
+		"agent_daily_limit":  wrapperOrNil(rule.GetAgentDailyLimit()),
+		"agent_weekly_limit": wrapperOrNil(rule.GetAgentWeeklyLimit()),
+		"daily_limit":        wrapperOrNil(rule.GetDailyLimit()),
+		"schedule":           util.StrPtrOrNil(rule.GetSchedule()),
+		"chunking":           jsonOrNil(rule.GetChunking()),
+		"priority":           max(rule.GetPriority(), model.RulePriorityDefault),
+	}
+}
+
+// questionnaireRuleColumn returns the column of an editable field, "from" and "to" are reserved words.
+func questionnaireRuleColumn(field string) string {
+	switch field {
+	case "from", "to":
+		return `"` + field + `"`
+	}
+	return field
+}
+
+func questionnaireRuleScanArgs(rule *cr.CallQuestionnaireRule, plan []QuestionnaireRuleScan) []any {
+	args := make([]any, 0, len(plan))
+	for _, scan := range plan {
+		args = append(args, scan(rule))
+	}
+	return args
+}
+
+// setQuestionnaireRuleEtag encodes the etag when the version has been selected.
+func setQuestionnaireRuleEtag(rule *cr.CallQuestionnaireRule, fields []string) {
+	if slices.Contains(fields, "ver") {
+		rule.Etag = util2.EncodeEtag(util2.EtagCallQuestionnaireRule, int64(rule.GetId()), rule.GetVer())
+	}
+}
+
+func lookupIDOrNil(lookup *cr.Lookup) *int64 {
+	if lookup.GetId() == 0 {
+		return nil
+	}
+	id := lookup.GetId()
+	return &id
+}
+
+func timeOrNil(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// jsonOrNil encodes the message as json with the proto field names, an empty message stays NULL.
+func jsonOrNil(m proto.Message) any {
+	if proto.Size(m) == 0 {
+		return nil
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	return string(data)
+}
+
+// wrapperOrNil unwraps a protobuf wrapper value, nil stays NULL.
+func wrapperOrNil[V, T any, W interface {
+	*V
+	GetValue() T
+}](w W) any {
+	if w == nil {
+		return nil
+	}
+	return w.GetValue()
+}
+
 func (s *CallQuestionnaireRuleStore) buildListCallQuestionnaireRuleQuery(
 	rpc options.SearchOptions,
 	callQuestionnaireRuleId int64,
 ) (sq.SelectBuilder, []QuestionnaireRuleScan, error) {
 	queryBuilder := sq.Select().
 		From("call_audit.call_questionnaire_rule AS cqr").
-		Where(sq.Eq{"cqr.domain": rpc.GetAuthOpts().GetDomainId()}).
+		Where(sq.Eq{"cqr.domain_id": rpc.GetAuthOpts().GetDomainId()}).
 		PlaceholderFormat(sq.Dollar)
 
 	// Add ID filter if provided
 	if len(rpc.GetIDs()) > 0 {
 		queryBuilder = queryBuilder.Where(sq.Eq{"cqr.id": rpc.GetIDs()})
 	}
+	if callQuestionnaireRuleId != 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"cqr.id": callQuestionnaireRuleId})
+	}
+	if q := rpc.GetSearch(); q != "" {
+		queryBuilder = util.AddSearchTerm(queryBuilder, q, util.Ident(cqrLeft, "name"))
+	}
+
+	// -------- Apply filters ----------
+	for name, value := range rpc.GetFilters() {
+		column, ok := questionnaireRuleFilterColumns[name]
+		if !ok {
+			return sq.SelectBuilder{}, nil, dberr.NewDBBadRequestError("postgres.questionnaire_rule.search.unknown_filter", name)
+		}
+		// filters arrive as text, compare in text form to support bool and integer columns
+		queryBuilder = queryBuilder.Where(fmt.Sprintf("%s::text = ?", column), fmt.Sprint(value))
+	}
 
 	// -------- Apply sorting ----------
-	queryBuilder = util.ApplyDefaultSorting(rpc, queryBuilder, closeQuestionnaireRuleDefaultSort)
+	field, direction := util.GetSortingOperator(rpc.GetSort())
+	column, ok := questionnaireRuleSortColumns[field]
+	if !ok {
+		column, direction = questionnaireRuleSortColumns[closeQuestionnaireRuleDefaultSort], util.SortAsc
+	}
+	queryBuilder = queryBuilder.OrderBy(fmt.Sprintf("%s %s", column, direction))
 
 	// ---------Apply paging based on Search Opts ( page ; size ) -----------------
 	queryBuilder = util.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
@@ -92,6 +419,19 @@ func buildQuestionnaireRuleSelectColumnsAndPlan(
 	fields []string,
 ) (sq.SelectBuilder, []QuestionnaireRuleScan, error) {
 	var plan []QuestionnaireRuleScan
+	// lookup selects the id column and the name resolved by nameQuery into the referenced lookup
+	lookup := func(idColumn, nameQuery string, ref func(rule *cr.CallQuestionnaireRule) **cr.Lookup) {
+		base = base.Column(util.Ident(cqrLeft, idColumn)).Column(nameQuery)
+		plan = append(plan,
+			func(rule *cr.CallQuestionnaireRule) any {
+				*ref(rule) = &cr.Lookup{}
+				return scanner.ScanInt64(&(*ref(rule)).Id)
+			},
+			func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&(*ref(rule)).Name)
+			},
+		)
+	}
 	for _, field := range fields {
 		switch field {
 		case "id":
@@ -99,44 +439,178 @@ func buildQuestionnaireRuleSelectColumnsAndPlan(
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
 				return &rule.Id
 			})
-		case "name":
-			base = base.Column(util.Ident(cqrLeft, "name"))
+		case "domain_id":
+			base = base.Column(util.Ident(cqrLeft, "domain_id"))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.Name
+				return &rule.DomainId
 			})
-		case "description":
-			base = base.Column(util.Ident(cqrLeft, "description"))
+		case "ver":
+			base = base.Column(util.Ident(cqrLeft, "ver"))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.Description
+				return &rule.Ver
 			})
-
 		case "created_at":
 			base = base.Column(util.Ident(cqrLeft, "created_at"))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.CreatedAt
+				return scanner.ScanProtoTimestamp(&rule.CreatedAt)
 			})
+		case "created_by":
+			lookup("created_by",
+				"(SELECT coalesce(u.name, u.username) FROM directory.wbt_user u WHERE u.id = cqr.created_by) AS created_by_name",
+				func(rule *cr.CallQuestionnaireRule) **cr.Lookup { return &rule.CreatedBy })
 		case "updated_at":
 			base = base.Column(util.Ident(cqrLeft, "updated_at"))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.UpdatedAt
+				return scanner.ScanProtoTimestamp(&rule.UpdatedAt)
+			})
+		case "updated_by":
+			lookup("updated_by",
+				"(SELECT coalesce(u.name, u.username) FROM directory.wbt_user u WHERE u.id = cqr.updated_by) AS updated_by_name",
+				func(rule *cr.CallQuestionnaireRule) **cr.Lookup { return &rule.UpdatedBy })
+		case "name":
+			base = base.Column(util.Ident(cqrLeft, "name"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return &rule.Name
+			})
+		case "language_profile":
+			lookup("language_profile",
+				"(SELECT lp.name FROM storage.language_profiles lp WHERE lp.id = cqr.language_profile) AS language_profile_name",
+				func(rule *cr.CallQuestionnaireRule) **cr.Lookup { return &rule.LanguageProfile })
+		case "description":
+			base = base.Column(util.Ident(cqrLeft, "description"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.Description)
 			})
 		case "enabled":
 			base = base.Column(util.Ident(cqrLeft, "enabled"))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.Enabled
+				return scanner.ScanBool(&rule.Enabled)
 			})
-		case "domain_id":
-			base = base.Column(util.Ident(cqrLeft, "domain_id"))
+		case "cognitive_profile":
+			lookup("cognitive_profile",
+				"(SELECT cp.name FROM storage.cognitive_profile_services cp WHERE cp.id = cqr.cognitive_profile) AS cognitive_profile_name",
+				func(rule *cr.CallQuestionnaireRule) **cr.Lookup { return &rule.CognitiveProfile })
+		case "from":
+			base = base.Column(util.Ident(cqrLeft, `"from"`))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.DomainId
+				return scanner.ScanProtoTimestamp(&rule.From)
+			})
+		case "to":
+			base = base.Column(util.Ident(cqrLeft, `"to"`))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanProtoTimestamp(&rule.To)
+			})
+		case "call_direction":
+			base = base.Column(util.Ident(cqrLeft, "call_direction"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.CallDirection)
+			})
+		case "min_call_duration":
+			base = base.Column(util.Ident(cqrLeft, "min_call_duration"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt32(&rule.MinCallDuration)
+			})
+		case "variable":
+			base = base.Column(util.Ident(cqrLeft, "variable"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.Variable)
+			})
+		case "default_promt":
+			base = base.Column(util.Ident(cqrLeft, "default_promt"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.DefaultPromt)
+			})
+		case "save_explanation":
+			base = base.Column(util.Ident(cqrLeft, "save_explanation"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanBool(&rule.SaveExplanation)
 			})
 		case "last_stored_at":
 			base = base.Column(util.Ident(cqrLeft, "last_stored_at"))
 			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
-				return &rule.LastStoredAt
+				return scanner.ScanProtoTimestamp(&rule.LastStoredAt)
+			})
+		case "model":
+			base = base.Column(util.Ident(cqrLeft, "model"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.Model)
+			})
+		case "temperature":
+			base = base.Column(util.Ident(cqrLeft, "temperature"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanDoubleValue(&rule.Temperature)
+			})
+		case "top_p":
+			base = base.Column(util.Ident(cqrLeft, "top_p"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanDoubleValue(&rule.TopP)
+			})
+		case "max_output_tokens":
+			base = base.Column(util.Ident(cqrLeft, "max_output_tokens"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt32Value(&rule.MaxOutputTokens)
+			})
+		case "seed":
+			base = base.Column(util.Ident(cqrLeft, "seed"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt64Value(&rule.Seed)
+			})
+		case "reasoning_effort":
+			base = base.Column(util.Ident(cqrLeft, "reasoning_effort"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.ReasoningEffort)
+			})
+		case "scorecard":
+			lookup("scorecard",
+				"(SELECT f.name FROM call_center.cc_audit_form f WHERE f.id = cqr.scorecard) AS scorecard_name",
+				func(rule *cr.CallQuestionnaireRule) **cr.Lookup { return &rule.Scorecard })
+		case "call_filter":
+			base = base.Column(util.Ident(cqrLeft, "call_filter"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanProtoJSON(&rule.CallFilter)
+			})
+		case "sample_percent":
+			base = base.Column(util.Ident(cqrLeft, "sample_percent"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt32Value(&rule.SamplePercent)
+			})
+		case "agent_daily_limit":
+			base = base.Column(util.Ident(cqrLeft, "agent_daily_limit"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt32Value(&rule.AgentDailyLimit)
+			})
+		case "agent_weekly_limit":
+			base = base.Column(util.Ident(cqrLeft, "agent_weekly_limit"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt32Value(&rule.AgentWeeklyLimit)
+			})
+		case "daily_limit":
+			base = base.Column(util.Ident(cqrLeft, "daily_limit"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanInt32Value(&rule.DailyLimit)
+			})
+		case "schedule":
+			base = base.Column(util.Ident(cqrLeft, "schedule"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.Schedule)
+			})
+		case "chunking":
+			base = base.Column(util.Ident(cqrLeft, "chunking"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanProtoJSON(&rule.Chunking)
+			})
+		case "paused_reason":
+			base = base.Column(util.Ident(cqrLeft, "paused_reason"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return scanner.ScanText(&rule.PausedReason)
+			})
+		case "priority":
+			base = base.Column(util.Ident(cqrLeft, "priority"))
+			plan = append(plan, func(rule *cr.CallQuestionnaireRule) any {
+				return &rule.Priority
 			})
 		default:
-			return base, nil, dberr.NewDBInternalError("postgres.close_reason.unknown_field", fmt.Errorf("unknown field: %s", field))
+			return base, nil, dberr.NewDBInternalError("postgres.call_questionnaire_rule.unknown_field", fmt.Errorf("unknown field: %s", field))
 		}
 	}
 	return base, plan, nil
@@ -0,0 +1,324 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_go "github.com/webitel/call_audit/api/call_audit"
+	dberr "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/postgres/scanner"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model"
+	"github.com/webitel/call_audit/model/options"
+)
+
+type JobScan func(job *_go.Job) any
+
+const (
+	jobLeft        = "j"
+	jobDefaultSort = "created_at"
+)
+
+// jobSortColumns maps the sortable fields to their columns.
+var jobSortColumns = map[string]string{
+	"id":          util.Ident(jobLeft, "id"),
+	"rule":        util.Ident(jobLeft, "rule_id"),
+	"state":       util.Ident(jobLeft, "state"),
+	"attempts":    util.Ident(jobLeft, "attempts"),
+	"next_run_at": util.Ident(jobLeft, "next_run_at"),
+	"created_at":  util.Ident(jobLeft, "created_at"),
+	"updated_at":  util.Ident(jobLeft, "updated_at"),
+}
+
+var (
+	// jobRetryStates are the states Retry moves back to pending.
+	jobRetryStates = []model.JobState{model.JobStateFailed, model.JobStateDead, model.JobStateCancelled, model.JobStateRetryScheduled}
+	// jobCancelStates are the states Cancel stops.
+	jobCancelStates = []model.JobState{model.JobStatePending, model.JobStateRunning, model.JobStateRetryScheduled}
+)
+
+// JobStore provides methods to query and manage the audit jobs in the database.
+type JobStore struct {
+	storage *Store
+}
+
+// NewJobStore creates a new JobStore.
+func NewJobStore(storage *Store) *JobStore {
+	return &JobStore{storage: storage}
+}
+
+// Search implements store.JobStore.
+func (s *JobStore) Search(rpc options.SearchOptions) ([]*_go.Job, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.job.search.database_connection_error", dbErr)
+	}
+
+	query, plan, err := s.buildSearchJobQuery(rpc)
+	if err != nil {
+		return nil, err
+	}
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.job.search.query_build_error", err)
+	}
+
+	rows, err := db.Query(rpc, sql, args...)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.job.search.execution_error", err)
+	}
+	defer rows.Close()
+
+	var items []*_go.Job
+	for rows.Next() {
+		item := &_go.Job{}
+		scanArgs := make([]any, 0, len(plan))
+		for _, scan := range plan {
+			scanArgs = append(scanArgs, scan(item))
+		}
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, dberr.NewDBInternalError("postgres.job.search.scan_error", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.job.search.rows_error", err)
+	}
+	return items, nil
+}
+
+// Retry implements store.JobStore.
+// The job runs again from the first attempt, a job in any other state than jobRetryStates is a conflict.
+func (s *JobStore) Retry(rpc options.UpdateOptions) error {
+	update := sq.Update("call_audit.jobs").
+		Set("state", model.JobStatePending).
+		Set("attempts", 0).
+		Set("error", nil).
+		Set("next_run_at", nil).
+		Set("updated_at", rpc.RequestTime()).
+		Where(sq.Eq{"state": jobRetryStates})
+	return s.setState(rpc, "retry", update)
+}
+
+// Cancel implements store.JobStore.
+// A running job loses its lease, so the worker finishing the audit leaves the job cancelled.
+func (s *JobStore) Cancel(rpc options.UpdateOptions) error {
+	update := sq.Update("call_audit.jobs").
+		Set("state", model.JobStateCancelled).
+		Set("next_run_at", nil).
+		Set("locked_by", nil).
+		Set("lease_until", nil).
+		Set("updated_at", rpc.RequestTime()).
+		Where(sq.Eq{"state": jobCancelStates})
+	return s.setState(rpc, "cancel", update)
+}
+
+// RetryFailed implements store.JobStore.
+func (s *JobStore) RetryFailed(rpc options.UpdateOptions, ruleID int64) (int64, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return 0, dberr.NewDBInternalError("postgres.job.retry_failed.database_connection_error", dbErr)
+	}
+	query, args, err := sq.Update("call_audit.jobs").
+		Set("state", model.JobStatePending).
+		Set("attempts", 0).
+		Set("error", nil).
+		Set("next_run_at", nil).
+		Set("updated_at", rpc.RequestTime()).
+		Where(sq.Eq{"rule_id": ruleID, "state": []model.JobState{model.JobStateFailed, model.JobStateDead}}).
+		Where(domainRuleIDs(rpc.GetAuthOpts().GetDomainId())).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, dberr.NewDBInternalError("postgres.job.retry_failed.query_build_error", err)
+	}
+	res, err := db.Exec(rpc, query, args...)
+	if err != nil {
+		return 0, dberr.NewDBInternalError("postgres.job.retry_failed.execution_error", err)
+	}
+	return res.RowsAffected(), nil
+}
+
+// setState applies the state change to the job of rpc.GetIDs() in the domain. No updated row
+// is a conflict when the job exists in a state the change does not apply to.
+func (s *JobStore) setState(rpc options.UpdateOptions, action string, update sq.UpdateBuilder) error {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return dberr.NewDBInternalError("postgres.job."+action+".database_connection_error", dbErr)
+	}
+	domainID := rpc.GetAuthOpts().GetDomainId()
+	query, args, err := update.
+		Where(sq.Eq{"id": rpc.GetIDs()}).
+		Where(domainRuleIDs(domainID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.job."+action+".query_build_error", err)
+	}
+	res, err := db.Exec(rpc, query, args...)
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.job."+action+".execution_error", err)
+	}
+	if res.RowsAffected() == 0 {
+		return s.wrongStateOrMissing(rpc, db, domainID, action, rpc.GetIDs())
+	}
+	return nil
+}
+
+func (s *JobStore) wrongStateOrMissing(ctx context.Context, db *pgxpool.Pool, domainID int64, action string, ids []int64) error {
+	query, args, err := sq.Select("count(*)").
+		From("call_audit.jobs").
+		Where(sq.Eq{"id": ids}).
+		Where(domainRuleIDs(domainID)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return dberr.NewDBInternalError("postgres.job."+action+".query_build_error", err)
+	}
+	var count int64
+	if err := db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return dberr.NewDBInternalError("postgres.job."+action+".execution_error", err)
+	}
+	if count == 0 {
+		return dberr.NewDBNoRowsError("postgres.job." + action + ".not_found")
+	}
+	return dberr.NewDBConflictError("postgres.job."+action+".conflict", "job state does not allow to "+action+" it")
+}
+
+// domainRuleIDs restricts the jobs to the rules of the domain.
+func domainRuleIDs(domainID int64) sq.Sqlizer {
+	return sq.Expr("rule_id IN (SELECT r.id FROM call_audit.call_questionnaire_rule r WHERE r.domain_id = ?)", domainID)
+}
+
+func (s *JobStore) buildSearchJobQuery(rpc options.SearchOptions) (sq.SelectBuilder, []JobScan, error) {
+	queryBuilder := sq.Select().
+		From("call_audit.jobs AS j").
+		Join("call_audit.call_questionnaire_rule AS r ON r.id = j.rule_id").
+		Where(sq.Eq{"r.domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+
+	if len(rpc.GetIDs()) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"j.id": rpc.GetIDs()})
+	}
+
+	// -------- Apply filters ----------
+	if v, ok := rpc.GetFilter("call_id").(string); ok && v != "" {
+		queryBuilder = queryBuilder.Where(sq.Eq{"j.params->>'call_id'": v})
+	}
+	if v, ok := rpc.GetFilter("rule_id").([]int64); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"j.rule_id": v})
+	}
+	if v, ok := rpc.GetFilter("state").([]model.JobState); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"j.state": v})
+	}
+	if v, ok := rpc.GetFilter("created_from").(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"j.created_at": v})
+	}
+	if v, ok := rpc.GetFilter("created_to").(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"j.created_at": v})
+	}
+
+	// -------- Apply sorting ----------
+	field, direction := util.GetSortingOperator(rpc.GetSort())
+	column, ok := jobSortColumns[field]
+	if !ok {
+		column, direction = jobSortColumns[jobDefaultSort], util.SortDesc
+	}
+	queryBuilder = queryBuilder.OrderBy(fmt.Sprintf("%s %s", column, direction), util.Ident(jobLeft, "id")+" "+direction)
+
+	// ---------Apply paging based on Search Opts ( page ; size ) -----------------
+	queryBuilder = util.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
+
+	// Add select columns and scan plan for requested fields
+	queryBuilder, plan, err := buildJobSelectColumnsAndPlan(queryBuilder, rpc.GetFields())
+	if err != nil {
+		return sq.SelectBuilder{}, nil, dberr.NewDBInternalError("postgres.job.search.query_build_error", err)
+	}
+	return queryBuilder, plan, nil
+}
+
+func buildJobSelectColumnsAndPlan(
+	base sq.SelectBuilder,
+	fields []string,
+) (sq.SelectBuilder, []JobScan, error) {
+	var plan []JobScan
+	for _, field := range fields {
+		switch field {
+		case "id":
+			base = base.Column(util.Ident(jobLeft, "id"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanInt64(&job.Id)
+			})
+		case "rule":
+			base = base.Column(util.Ident(jobLeft, "rule_id")).
+				Column("r.name AS rule_name")
+			plan = append(plan,
+				func(job *_go.Job) any {
+					job.Rule = &_go.Lookup{}
+					return scanner.ScanInt64(&job.Rule.Id)
+				},
+				func(job *_go.Job) any {
+					return scanner.ScanText(&job.Rule.Name)
+				},
+			)
+		case "call_id":
+			base = base.Column("j.params->>'call_id' AS call_id")
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanText(&job.CallId)
+			})
+		case "state":
+			base = base.Column(util.Ident(jobLeft, "state"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanFunc(func(src any) error {
+					var state int32
+					if err := scanner.ScanInt32(&state).(scanner.ScanFunc)(src); err != nil {
+						return err
+					}
+					job.State = _go.JobState(state)
+					return nil
+				})
+			})
+		case "attempts":
+			base = base.Column(util.Ident(jobLeft, "attempts"))
+			plan = append(plan, func(job *_go.Job) any {
+				return &job.Attempts
+			})
+		case "error":
+			base = base.Column(util.Ident(jobLeft, "error"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanText(&job.Error)
+			})
+		case "next_run_at":
+			base = base.Column(util.Ident(jobLeft, "next_run_at"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanProtoTimestamp(&job.NextRunAt)
+			})
+		case "created_at":
+			base = base.Column(util.Ident(jobLeft, "created_at"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanProtoTimestamp(&job.CreatedAt)
+			})
+		case "updated_at":
+			base = base.Column(util.Ident(jobLeft, "updated_at"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanProtoTimestamp(&job.UpdatedAt)
+			})
+		case "locked_by":
+			base = base.Column(util.Ident(jobLeft, "locked_by"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanText(&job.LockedBy)
+			})
+		case "lease_until":
+			base = base.Column(util.Ident(jobLeft, "lease_until"))
+			plan = append(plan, func(job *_go.Job) any {
+				return scanner.ScanProtoTimestamp(&job.LeaseUntil)
+			})
+		default:
+			return base, nil, dberr.NewDBInternalError("postgres.job.unknown_field", fmt.Errorf("unknown field: %s", field))
+		}
+	}
+	return base, plan, nil
+}
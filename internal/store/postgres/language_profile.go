@@ -2,12 +2,21 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	pb "github.com/webitel/call_audit/api/call_audit"
+	"github.com/webitel/call_audit/internal/store/postgres/scanner"
+	"github.com/webitel/call_audit/model/options"
+	"github.com/webitel/call_audit/util"
 	"github.com/webitel/storage/model"
 )
 
+// languageProfileColumns is the select list read by scanLanguageProfile.
+const languageProfileColumns = `id, domain_id, created_at, created_by, updated_at, updated_by, name, token, "type", ver`
+
 // LanguageProfileStore provides methods to interact with language profiles in the database.
 type LanguageProfileStore struct {
 	storage *Store
@@ -34,59 +43,73 @@ func (l *LanguageProfileStore) Create(ctx context.Context, req *pb.CreateLanguag
         VALUES
             ($1,        $2,         $2,        $3,   $4,    $5)
         RETURNING
-            id, domain_id, created_at, created_by, updated_at, updated_by, name, token, "type"
-    `
+            ` + languageProfileColumns
 
-	var lp pb.LanguageProfile
-	// Adjust Scan order to your proto struct fields or scan into temps then map
-	err := db.QueryRow(ctx, q,
+	lp, err := scanLanguageProfile(db.QueryRow(ctx, q,
 		domainID, userID, name, token, typ,
-	).Scan(
-		&lp.Id,
-		&lp.DomainId,
-		&lp.CreatedAt, 
-		&lp.CreatedBy,
-		&lp.UpdatedAt,
-		&lp.UpdatedBy,
-		&lp.Name,
-		&lp.Token,
-		&lp.Type,
-	)
+	))
 	if err != nil {
 		return nil, model.NewCustomCodeError("store.language_profile.create.app_error", err.Error(), 500)
 	}
-	return &lp, nil
+	return lp, nil
 }
 
 // Delete implements store.LanguageProfileStore.
-func (l *LanguageProfileStore) Delete(ctx context.Context, id int32) error {
+// A "ver" filter restricts the delete to that row version, a changed profile is a conflict.
+func (l *LanguageProfileStore) Delete(rpc options.DeleteOptions) error {
+	ids := rpc.GetIDs()
 	db, dbErr := l.storage.Database()
 	if dbErr != nil {
-		return model.NewCustomCodeError("store.language_profile.delete.app_error", fmt.Sprintf("Id=%v, %s", id, dbErr.Error()), 400)
+		return model.NewCustomCodeError("store.language_profile.delete.app_error", fmt.Sprintf("Id=%v, %s", ids, dbErr.Error()), 400)
+	}
+
+	deleteBuilder := sq.Delete("storage.language_profiles").
+		Where(sq.Eq{"id": ids, "domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+	ver, versioned := rpc.GetFilter("ver").(int32)
+	if versioned {
+		deleteBuilder = deleteBuilder.Where(sq.Eq{"ver": ver})
+	}
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		return model.NewCustomCodeError("store.language_profile.delete.app_error", err.Error(), 500)
+	}
+
+	res, err := db.Exec(rpc, query, args...)
+	if err != nil {
+		return model.NewCustomCodeError("store.language_profile.delete.app_error", fmt.Sprintf("Id=%v, %s", ids, err.Error()), 400)
 	}
-	if _, err := db.Exec(ctx, `delete from storage.language_profiles c where c.id=:Id`,
-		map[string]interface{}{"Id": id}); err != nil {
-		return model.NewCustomCodeError("store.language_profile.delete.app_error", fmt.Sprintf("Id=%v, %s", id, err.Error()), 400)
+	if res.RowsAffected() == 0 {
+		if versioned {
+			return l.staleOrMissing(rpc, rpc.GetAuthOpts().GetDomainId(), "delete", ids)
+		}
+		return model.NewCustomCodeError("store.language_profile.delete.not_found", fmt.Sprintf("Id=%v", ids), 404)
 	}
 	return nil
 }
 
 // Get implements store.LanguageProfileStore.
-func (l *LanguageProfileStore) Get(ctx context.Context, id int32) (*pb.LanguageProfile, error) {
+// The profile is looked up in the domain of the session only.
+func (l *LanguageProfileStore) Get(rpc options.SearchOptions) (*pb.LanguageProfile, error) {
+	id := rpc.GetIDs()
 	db, dbErr := l.storage.Database()
 	if dbErr != nil {
 		return nil, model.NewCustomCodeError("store.language_profile.get.app_error", fmt.Sprintf("Id=%v, %s", id, dbErr.Error()), 400)
 	}
-	var profile pb.LanguageProfile
-	if err := db.QueryRow(ctx, `select * from storage.language_profiles c where c.id=:Id`,
-		map[string]interface{}{"Id": id}).Scan(&profile); err != nil {
+	profile, err := scanLanguageProfile(db.QueryRow(rpc, `select `+languageProfileColumns+` from storage.language_profiles c where c.id = any($1) and c.domain_id = $2`,
+		id, rpc.GetAuthOpts().GetDomainId()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.NewCustomCodeError("store.language_profile.get.not_found", fmt.Sprintf("Id=%v", id), 404)
+	}
+	if err != nil {
 		return nil, model.NewCustomCodeError("store.language_profile.get.app_error", fmt.Sprintf("Id=%v, %s", id, err.Error()), 400)
 	}
-	return &profile, nil
+	return profile, nil
 }
 
 // List implements store.LanguageProfileStore.
-func (l *LanguageProfileStore) List(ctx context.Context) (*pb.ListLanguageProfilesResponse, error) {
+// Only the profiles of the domain of the session are listed.
+func (l *LanguageProfileStore) List(rpc options.SearchOptions) (*pb.ListLanguageProfilesResponse, error) {
 	db, dbErr := l.storage.Database()
 	if dbErr != nil {
 		return nil, model.NewCustomCodeError("store.language_profile.list.app_error", dbErr.Error(), 500)
@@ -94,28 +117,127 @@ func (l *LanguageProfileStore) List(ctx context.Context) (*pb.ListLanguageProfil
 
 	var profiles []*pb.LanguageProfile
 
-	rows, err := db.Query(ctx, `select * from storage.language_profiles`)
+	rows, err := db.Query(rpc, `select `+languageProfileColumns+` from storage.language_profiles c where c.domain_id = $1 order by c.id`,
+		rpc.GetAuthOpts().GetDomainId())
 	if err != nil {
 		return nil, model.NewCustomCodeError("store.language_profile.list.app_error", err.Error(), 500)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var profile pb.LanguageProfile
-		if err := rows.Scan(&profile); err != nil {
+		profile, err := scanLanguageProfile(rows)
+		if err != nil {
 			return nil, model.NewCustomCodeError("store.language_profile.list.app_error", err.Error(), 500)
 		}
-		profiles = append(profiles, &profile)
+		profiles = append(profiles, profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, model.NewCustomCodeError("store.language_profile.list.app_error", err.Error(), 500)
 	}
 
 	return &pb.ListLanguageProfilesResponse{Profiles: profiles}, nil
 }
 
 // Update implements store.LanguageProfileStore.
-func (l *LanguageProfileStore) Update(ctx context.Context, profile *pb.UpdateLanguageProfileRequest) (*pb.LanguageProfile, error) {
-	a, _ := l.storage.Database()
-	a.Exec(ctx, "SELECT 1") // Example query to check connection
-	panic("unimplemented")
+// With a mask only the masked name, token and type are updated, otherwise all of them.
+// When the profile carries an etag, its Ver is the version the caller has read and a changed row is a conflict.
+func (l *LanguageProfileStore) Update(rpc options.UpdateOptions, profile *pb.LanguageProfile) (*pb.LanguageProfile, error) {
+	db, dbErr := l.storage.Database()
+	if dbErr != nil {
+		return nil, model.NewCustomCodeError("store.language_profile.update.app_error", dbErr.Error(), 500)
+	}
+
+	values := map[string]any{
+		"name":  profile.GetName(),
+		"token": profile.GetToken(),
+		"type":  profile.GetType(),
+	}
+	if mask := rpc.GetMask(); len(mask) > 0 {
+		masked := make(map[string]any, len(mask))
+		for _, field := range mask {
+			if field == "id" || field == "ver" || field == "etag" {
+				continue
+			}
+			v, ok := values[field]
+			if !ok {
+				return nil, model.NewCustomCodeError("store.language_profile.update.app_error", fmt.Sprintf("Id=%v, unknown field: %s", profile.GetId(), field), 400)
+			}
+			masked[field] = v
+		}
+		values = masked
+	}
+	if v, ok := values["type"]; ok {
+		delete(values, "type")
+		values[`"type"`] = v
+	}
+
+	versioned := profile.GetEtag() != ""
+	update := sq.Update("storage.language_profiles").
+		SetMap(values).
+		Set("updated_at", rpc.RequestTime()).
+		Set("updated_by", rpc.GetAuthOpts().GetUserId()).
+		Set("ver", sq.Expr("ver + 1")).
+		Where(sq.Eq{"id": profile.GetId(), "domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		Suffix(`RETURNING ` + languageProfileColumns).
+		PlaceholderFormat(sq.Dollar)
+	if versioned {
+		update = update.Where(sq.Eq{"ver": profile.GetVer()})
+	}
+	query, args, err := update.ToSql()
+	if err != nil {
+		return nil, model.NewCustomCodeError("store.language_profile.update.app_error", err.Error(), 500)
+	}
+
+	lp, err := scanLanguageProfile(db.QueryRow(rpc, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		if versioned {
+			return nil, l.staleOrMissing(rpc, rpc.GetAuthOpts().GetDomainId(), "update", []int64{int64(profile.GetId())})
+		}
+		return nil, model.NewCustomCodeError("store.language_profile.update.not_found", fmt.Sprintf("Id=%v", profile.GetId()), 404)
+	}
+	if err != nil {
+		return nil, model.NewCustomCodeError("store.language_profile.update.app_error", fmt.Sprintf("Id=%v, %s", profile.GetId(), err.Error()), 500)
+	}
+	return lp, nil
+}
+
+// staleOrMissing tells a profile changed since the supplied etag apart from a missing one
+// after a versioned statement affected no rows.
+func (l *LanguageProfileStore) staleOrMissing(ctx context.Context, domainID int64, action string, ids []int64) error {
+	db, dbErr := l.storage.Database()
+	if dbErr != nil {
+		return model.NewCustomCodeError("store.language_profile."+action+".app_error", dbErr.Error(), 500)
+	}
+	var exists bool
+	if err := db.QueryRow(ctx, `select exists(select 1 from storage.language_profiles c where c.id = any($1) and c.domain_id = $2)`, ids, domainID).Scan(&exists); err != nil {
+		return model.NewCustomCodeError("store.language_profile."+action+".app_error", err.Error(), 500)
+	}
+	if !exists {
+		return model.NewCustomCodeError("store.language_profile."+action+".not_found", fmt.Sprintf("Id=%v", ids), 404)
+	}
+	return model.NewCustomCodeError("store.language_profile."+action+".conflict", fmt.Sprintf("Id=%v, profile has been changed, reload it and try again", ids), 409)
+}
+
+// scanLanguageProfile reads a row selected with languageProfileColumns and encodes its etag.
+func scanLanguageProfile(row pgx.Row) (*pb.LanguageProfile, error) {
+	var lp pb.LanguageProfile
+	err := row.Scan(
+		&lp.Id,
+		&lp.DomainId,
+		scanner.ScanProtoTimestamp(&lp.CreatedAt),
+		scanner.ScanInt64(&lp.CreatedBy),
+		scanner.ScanProtoTimestamp(&lp.UpdatedAt),
+		scanner.ScanInt64(&lp.UpdatedBy),
+		&lp.Name,
+		scanner.ScanText(&lp.Token),
+		&lp.Type,
+		&lp.Ver,
+	)
+	if err != nil {
+		return nil, err
+	}
+	lp.Etag = util.EncodeEtag(util.EtagLanguageProfile, int64(lp.Id), lp.Ver)
+	return &lp, nil
 }
 
 // NewLanguageProfileStore creates a new LanguageProfileStore.
@@ -0,0 +1,33 @@
+package scanner
+
+import (
+	"github.com/jackc/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ScanProtoJSON scans a nullable json column into a new message, NULL leaves it nil.
+func ScanProtoJSON[T any, M interface {
+	*T
+	proto.Message
+}](value *M) any {
+	return ScanFunc(func(src any) error {
+		*value = nil
+		if src == nil {
+			return nil
+		}
+		t := pgtype.Text{}
+		if err := t.Scan(src); err != nil {
+			return err
+		}
+		if t.Status != pgtype.Present {
+			return nil
+		}
+		msg := M(new(T))
+		if err := protojson.Unmarshal([]byte(t.String), msg); err != nil {
+			return err
+		}
+		*value = msg
+		return nil
+	})
+}
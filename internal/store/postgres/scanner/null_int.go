@@ -32,4 +32,19 @@ func ScanInt(value *int) any {
 		*value = int(t.Int)
 		return nil
 	})
-}
\ No newline at end of file
+}
+// ScanInt32 safely scans an SQL value into an *int32.
+func ScanInt32(value *int32) any {
+	return ScanFunc(func(src any) error {
+		if src == nil {
+			return nil
+		}
+		t := pgtype.Int4{}
+		err := t.Scan(src)
+		if err != nil {
+			return err
+		}
+		*value = t.Int
+		return nil
+	})
+}
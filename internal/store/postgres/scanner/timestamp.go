@@ -3,6 +3,8 @@ package scanner
 import (
 	"fmt"
 	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ScanTimestamp(ref *int64) any {
@@ -68,3 +70,19 @@ func ScanTimestamp(ref *int64) any {
 		return nil
 	})
 }
+
+// ScanProtoTimestamp scans a timestamptz value into a protobuf timestamp, NULL leaves it nil.
+func ScanProtoTimestamp(ref **timestamppb.Timestamp) any {
+	return ScanFunc(func(src any) error {
+		if src == nil {
+			*ref = nil
+			return nil
+		}
+		var ms int64
+		if err := ScanTimestamp(&ms).(ScanFunc)(src); err != nil {
+			return err
+		}
+		*ref = timestamppb.New(time.UnixMilli(ms))
+		return nil
+	})
+}
@@ -0,0 +1,60 @@
+package scanner
+
+import (
+	"github.com/jackc/pgtype"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ScanDoubleValue scans a nullable float into a protobuf wrapper, NULL leaves it nil.
+func ScanDoubleValue(value **wrapperspb.DoubleValue) any {
+	return ScanFunc(func(src any) error {
+		*value = nil
+		if src == nil {
+			return nil
+		}
+		t := pgtype.Float8{}
+		if err := t.Scan(src); err != nil {
+			return err
+		}
+		if t.Status == pgtype.Present {
+			*value = wrapperspb.Double(t.Float)
+		}
+		return nil
+	})
+}
+
+// ScanInt32Value scans a nullable integer into a protobuf wrapper, NULL leaves it nil.
+func ScanInt32Value(value **wrapperspb.Int32Value) any {
+	return ScanFunc(func(src any) error {
+		*value = nil
+		if src == nil {
+			return nil
+		}
+		t := pgtype.Int4{}
+		if err := t.Scan(src); err != nil {
+			return err
+		}
+		if t.Status == pgtype.Present {
+			*value = wrapperspb.Int32(t.Int)
+		}
+		return nil
+	})
+}
+
+// ScanInt64Value scans a nullable bigint into a protobuf wrapper, NULL leaves it nil.
+func ScanInt64Value(value **wrapperspb.Int64Value) any {
+	return ScanFunc(func(src any) error {
+		*value = nil
+		if src == nil {
+			return nil
+		}
+		t := pgtype.Int8{}
+		if err := t.Scan(src); err != nil {
+			return err
+		}
+		if t.Status == pgtype.Present {
+			*value = wrapperspb.Int64(t.Int)
+		}
+		return nil
+	})
+}
@@ -16,6 +16,10 @@ type Store struct {
 	//------------call_audit stores ------------ ----//
 	languageProfilesStore      store.LanguageProfileStore
 	callQuestionnaireRuleStore store.CallQuestionnaireRuleStore
+	auditResultStore           store.AuditResultStore
+	jobStore                   store.JobStore
+	backfillStore              store.BackfillStore
+	usageStore                 store.UsageStore
 
 	serviceStore store.ServiceStore
 	config       *conf.DatabaseConfig
@@ -43,6 +47,34 @@ func (s *Store) CallQuestionnaireRules() store.CallQuestionnaireRuleStore {
 	return s.callQuestionnaireRuleStore
 }
 
+func (s *Store) AuditResults() store.AuditResultStore {
+	if s.auditResultStore == nil {
+		s.auditResultStore = NewAuditResultStore(s)
+	}
+	return s.auditResultStore
+}
+
+func (s *Store) Jobs() store.JobStore {
+	if s.jobStore == nil {
+		s.jobStore = NewJobStore(s)
+	}
+	return s.jobStore
+}
+
+func (s *Store) Backfills() store.BackfillStore {
+	if s.backfillStore == nil {
+		s.backfillStore = NewBackfillStore(s)
+	}
+	return s.backfillStore
+}
+
+func (s *Store) Usage() store.UsageStore {
+	if s.usageStore == nil {
+		s.usageStore = NewUsageStore(s)
+	}
+	return s.usageStore
+}
+
 func (s *Store) ServiceStore() store.ServiceStore {
 	if s.serviceStore == nil {
 		s.serviceStore = NewServiceStore(s)
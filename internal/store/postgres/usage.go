@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_go "github.com/webitel/call_audit/api/call_audit"
+	dberr "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/internal/store/util"
+	"github.com/webitel/call_audit/model/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UsageStore provides methods to query the LLM usage and manage the budget of a domain in the database.
+type UsageStore struct {
+	storage *Store
+}
+
+// NewUsageStore creates a new UsageStore.
+func NewUsageStore(storage *Store) *UsageStore {
+	return &UsageStore{storage: storage}
+}
+
+// Search implements store.UsageStore.
+// The usage is summed per UTC day, rule and model, the newest day first.
+func (s *UsageStore) Search(rpc options.SearchOptions) ([]*_go.Usage, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.search.database_connection_error", dbErr)
+	}
+
+	queryBuilder := sq.Select(
+		"to_char(u.created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS day",
+		"u.rule_id",
+		"(SELECT r.name FROM call_audit.call_questionnaire_rule r WHERE r.id = u.rule_id) AS rule_name",
+		"COALESCE(u.model, '') AS model",
+		"count(*) AS requests",
+		"sum(u.prompt_tokens)::int8 AS prompt_tokens",
+		"sum(u.completion_tokens)::int8 AS completion_tokens",
+		"sum(u.cost)::float8 AS cost",
+	).
+		From("call_audit.llm_usage AS u").
+		Where(sq.Eq{"u.domain_id": rpc.GetAuthOpts().GetDomainId()}).
+		GroupBy("day", "u.rule_id", "u.model").
+		OrderBy("day DESC", "u.rule_id NULLS FIRST", "model").
+		PlaceholderFormat(sq.Dollar)
+	if v, ok := rpc.GetFilter("rule_id").([]int64); ok && len(v) > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"u.rule_id": v})
+	}
+	if v, ok := rpc.GetFilter("from").(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.GtOrEq{"u.created_at": v})
+	}
+	if v, ok := rpc.GetFilter("to").(time.Time); ok {
+		queryBuilder = queryBuilder.Where(sq.LtOrEq{"u.created_at": v})
+	}
+	queryBuilder = util.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.search.query_build_error", err)
+	}
+	rows, err := db.Query(rpc, query, args...)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.search.execution_error", err)
+	}
+	defer rows.Close()
+
+	var items []*_go.Usage
+	for rows.Next() {
+		var (
+			item     _go.Usage
+			ruleID   *int64
+			ruleName *string
+		)
+		if err := rows.Scan(&item.Day, &ruleID, &ruleName, &item.Model, &item.Requests, &item.PromptTokens, &item.CompletionTokens, &item.Cost); err != nil {
+			return nil, dberr.NewDBInternalError("postgres.usage.search.scan_error", err)
+		}
+		if ruleID != nil {
+			item.Rule = &_go.Lookup{Id: *ruleID}
+			if ruleName != nil {
+				item.Rule.Name = *ruleName
+			}
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.search.rows_error", err)
+	}
+	return items, nil
+}
+
+// GetBudget implements store.UsageStore.
+func (s *UsageStore) GetBudget(rpc options.SearchOptions) (*_go.Budget, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.get_budget.database_connection_error", dbErr)
+	}
+	budget, err := s.budget(rpc, db, rpc.GetAuthOpts().GetDomainId())
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.get_budget.execution_error", err)
+	}
+	return budget, nil
+}
+
+// SetBudget implements store.UsageStore.
+func (s *UsageStore) SetBudget(rpc options.UpdateOptions, monthlyLimit float64) (*_go.Budget, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.set_budget.database_connection_error", dbErr)
+	}
+	domainID := rpc.GetAuthOpts().GetDomainId()
+
+	query, args, err := sq.Insert("call_audit.budgets").
+		SetMap(map[string]any{
+			"domain_id":     domainID,
+			"monthly_limit": monthlyLimit,
+			"updated_at":    rpc.RequestTime(),
+			"updated_by":    rpc.GetAuthOpts().GetUserId(),
+		}).
+		Suffix("ON CONFLICT (domain_id) DO UPDATE SET monthly_limit = EXCLUDED.monthly_limit, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.set_budget.query_build_error", err)
+	}
+	if _, err := db.Exec(rpc, query, args...); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.set_budget.execution_error", err)
+	}
+
+	budget, err := s.budget(rpc, db, domainID)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.set_budget.execution_error", err)
+	}
+	return budget, nil
+}
+
+// DeleteBudget implements store.UsageStore.
+func (s *UsageStore) DeleteBudget(rpc options.DeleteOptions) (*_go.Budget, error) {
+	db, dbErr := s.storage.Database()
+	if dbErr != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.delete_budget.database_connection_error", dbErr)
+	}
+	domainID := rpc.GetAuthOpts().GetDomainId()
+
+	query, args, err := sq.Delete("call_audit.budgets").
+		Where(sq.Eq{"domain_id": domainID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.delete_budget.query_build_error", err)
+	}
+	if _, err := db.Exec(rpc, query, args...); err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.delete_budget.execution_error", err)
+	}
+
+	budget, err := s.budget(rpc, db, domainID)
+	if err != nil {
+		return nil, dberr.NewDBInternalError("postgres.usage.delete_budget.execution_error", err)
+	}
+	return budget, nil
+}
+
+// budget reads the budget of the domain and its cost of the current UTC month, a zero limit without a budget.
+func (s *UsageStore) budget(ctx context.Context, db *pgxpool.Pool, domainID int64) (*_go.Budget, error) {
+	var (
+		budget        _go.Budget
+		updatedAt     *time.Time
+		updatedBy     *int64
+		updatedByName *string
+	)
+	err := db.QueryRow(ctx, `
+		SELECT
+			COALESCE(b.monthly_limit, 0)::float8,
+			(
+				SELECT COALESCE(sum(u.cost), 0)::float8
+				FROM call_audit.llm_usage u
+				WHERE u.domain_id = $1 AND u.created_at >= date_trunc('month', NOW(), 'UTC')
+			),
+			b.updated_at,
+			b.updated_by,
+			(SELECT coalesce(wu.name, wu.username) FROM directory.wbt_user wu WHERE wu.id = b.updated_by)
+		FROM (SELECT $1::int8 AS domain_id) d
+		LEFT JOIN call_audit.budgets b ON b.domain_id = d.domain_id
+	`, domainID).Scan(&budget.MonthlyLimit, &budget.Spent, &updatedAt, &updatedBy, &updatedByName)
+	if err != nil {
+		return nil, err
+	}
+	budget.Exhausted = budget.MonthlyLimit > 0 && budget.Spent >= budget.MonthlyLimit
+	if updatedAt != nil {
+		budget.UpdatedAt = timestamppb.New(*updatedAt)
+	}
+	if updatedBy != nil {
+		budget.UpdatedBy = &_go.Lookup{Id: *updatedBy}
+		if updatedByName != nil {
+			budget.UpdatedBy.Name = *updatedByName
+		}
+	}
+	return &budget, nil
+}
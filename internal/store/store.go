@@ -5,11 +5,16 @@ import (
 
 	_go "github.com/webitel/call_audit/api/call_audit"
 	dberr "github.com/webitel/call_audit/internal/errors"
+	"github.com/webitel/call_audit/model/options"
 )
 
 type Store interface {
 	LanguageProfiles() LanguageProfileStore
 	CallQuestionnaireRules() CallQuestionnaireRuleStore
+	AuditResults() AuditResultStore
+	Jobs() JobStore
+	Backfills() BackfillStore
+	Usage() UsageStore
 	ServiceStore() ServiceStore
 	// ------------ Database Management ------------ //
 	Open() *dberr.DBError  // Return custom DB error
@@ -20,19 +25,51 @@ type Store interface {
 // LanguageProfileStore defines the methods for managing language profiles.
 type LanguageProfileStore interface {
 	Create(ctx context.Context, profile *_go.CreateLanguageProfileRequest) (*_go.LanguageProfile, error)
-	Get(ctx context.Context, id int32) (*_go.LanguageProfile, error)
-	Update(ctx context.Context, profile *_go.UpdateLanguageProfileRequest) (*_go.LanguageProfile, error)
-	Delete(ctx context.Context, id int32) error
-	List(ctx context.Context) (*_go.ListLanguageProfilesResponse, error)
+	Get(rpc options.SearchOptions) (*_go.LanguageProfile, error)
+	Update(rpc options.UpdateOptions, profile *_go.LanguageProfile) (*_go.LanguageProfile, error)
+	Delete(rpc options.DeleteOptions) error
+	List(rpc options.SearchOptions) (*_go.ListLanguageProfilesResponse, error)
 }
 
 // CallQuestionnaireRuleStore defines the methods for managing call questionnaire rules.
 type CallQuestionnaireRuleStore interface {
-	Create(ctx context.Context, rule *_go.CallQuestionnaireRule) (*_go.CallQuestionnaireRule, error)
-	Get(ctx context.Context, id int64) (*_go.CallQuestionnaireRule, error)
-	Update(ctx context.Context, rule *_go.CallQuestionnaireRule) (*_go.CallQuestionnaireRule, error)
-	Delete(ctx context.Context, id int64) error
-	List(ctx context.Context) (*_go.CallQuestionnaireRuleList, error)
+	Create(rpc options.CreateOptions, rule *_go.CallQuestionnaireRule) (*_go.CallQuestionnaireRule, error)
+	Get(rpc options.SearchOptions) (*_go.CallQuestionnaireRule, error)
+	Update(rpc options.UpdateOptions, rule *_go.CallQuestionnaireRule) (*_go.CallQuestionnaireRule, error)
+	Delete(rpc options.DeleteOptions) error
+	List(rpc options.SearchOptions) (*_go.CallQuestionnaireRuleList, error)
+}
+
+// AuditResultStore defines the methods for querying stored audit results.
+type AuditResultStore interface {
+	Search(rpc options.SearchOptions) ([]*_go.AuditResult, error)
+}
+
+// JobStore defines the methods for querying and managing the audit jobs.
+type JobStore interface {
+	Search(rpc options.SearchOptions) ([]*_go.Job, error)
+	Retry(rpc options.UpdateOptions) error
+	Cancel(rpc options.UpdateOptions) error
+	// RetryFailed moves the failed and dead jobs of the rule back to pending and returns their number.
+	RetryFailed(rpc options.UpdateOptions, ruleID int64) (int64, error)
+}
+
+// BackfillStore defines the methods for managing the rule backfills.
+type BackfillStore interface {
+	Create(rpc options.CreateOptions, backfill *_go.Backfill) (*_go.Backfill, error)
+	Search(rpc options.SearchOptions) ([]*_go.Backfill, error)
+	Pause(rpc options.UpdateOptions) (*_go.Backfill, error)
+	Resume(rpc options.UpdateOptions) (*_go.Backfill, error)
+	Cancel(rpc options.UpdateOptions) (*_go.Backfill, error)
+}
+
+// UsageStore defines the methods for querying the LLM usage and managing the budget of a domain.
+type UsageStore interface {
+	Search(rpc options.SearchOptions) ([]*_go.Usage, error)
+	GetBudget(rpc options.SearchOptions) (*_go.Budget, error)
+	SetBudget(rpc options.UpdateOptions, monthlyLimit float64) (*_go.Budget, error)
+	// DeleteBudget removes the budget and returns the cost spent without it.
+	DeleteBudget(rpc options.DeleteOptions) (*_go.Budget, error)
 }
 
 type ServiceStore interface {
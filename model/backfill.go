@@ -0,0 +1,18 @@
+package model
+
+// BackfillState is the state of a call_audit.backfills row:
+// running <-> paused, running | paused -> cancelled, running -> completed.
+type BackfillState int
+
+const (
+	BackfillStateRunning   BackfillState = 0
+	BackfillStatePaused    BackfillState = 1
+	BackfillStateCancelled BackfillState = 2
+	BackfillStateCompleted BackfillState = 3
+)
+
+const (
+	// BackfillDefaultBatchSize is the number of unfinished jobs a backfill keeps queued.
+	BackfillDefaultBatchSize = 50
+	BackfillMaxBatchSize     = 1000
+)
@@ -1,47 +1,168 @@
 package model
 
 import (
+	"fmt"
 	"time"
 )
 
 type CallQuestionnaireRule struct {
-	Id                    int        `db:"id"`
-	DomainId              int        `db:"domain_id"`
-	CreatedAt             time.Time  `db:"created_at"`
-	CreatedBy             int64      `db:"created_by"`
-	UpdatedAt             time.Time  `db:"updated_at"`
-	UpdatedBy             int64      `db:"updated_by"`
-	Last                  time.Time  `db:"last"`
-	LastStoredAt          *time.Time `db:"last_stored_at"`
-	Enabled               bool       `db:"enabled"`
-	Name                  string     `db:"name"`
-	Description           *string    `db:"description"`
-	CallDirection         string     `db:"call_direction"`
-	LanguageProfile       int        `db:"language_profile"`  // ID
-	CognitiveProfile      int        `db:"cognitive_profile"` // ID
-	From                  time.Time  `db:"from"`
-	To                    *time.Time `db:"to"`
-	MinCallDuration       *int32     `db:"min_call_duration"`
-	Variable              *string    `db:"variable"`
-	DefaultPrompt         *string    `db:"default_promt"`
-	SaveExplanation       *bool      `db:"save_explanation"`
-	LanguageProfileToken  *string    `db:"language_token"` // from join
-	CognitiveProfileToken *string    `db:"cognitive_key"`  // from join
-	Active                int32      `db:"active"`
-	Scorecard             int32      `db:"scorecard"` // ID of the scorecard form
+	Id                    int                 `db:"id"`
+	DomainId              int                 `db:"domain_id"`
+	CreatedAt             time.Time           `db:"created_at"`
+	CreatedBy             int64               `db:"created_by"`
+	UpdatedAt             time.Time           `db:"updated_at"`
+	UpdatedBy             int64               `db:"updated_by"`
+	Last                  time.Time           `db:"last"`
+	LastStoredAt          *time.Time          `db:"last_stored_at"`
+	Enabled               bool                `db:"enabled"`
+	Name                  string              `db:"name"`
+	Description           *string             `db:"description"`
+	CallDirection         string              `db:"call_direction"`
+	LanguageProfile       int                 `db:"language_profile"`  // ID
+	CognitiveProfile      int                 `db:"cognitive_profile"` // ID
+	From                  time.Time           `db:"from"`
+	To                    *time.Time          `db:"to"`
+	MinCallDuration       *int32              `db:"min_call_duration"`
+	Variable              *string             `db:"variable"`
+	DefaultPrompt         *string             `db:"default_promt"`
+	SaveExplanation       *bool               `db:"save_explanation"`
+	LanguageProfileToken  *string             `db:"language_token"` // from join
+	CognitiveProfileToken *string             `db:"cognitive_key"`  // from join
+	Provider              *string             `db:"provider"`       // from join
+	ProviderURL           *string             `db:"provider_url"`   // from join
+	ProviderAPIVersion    *string             `db:"provider_api_version"`
+	ProviderDeployment    *string             `db:"provider_deployment"`
+	Active                int32               `db:"active"`
+	Scorecard             int32               `db:"scorecard"` // ID of the scorecard form
+	Model                 *string             `db:"model"`
+	Temperature           *float64            `db:"temperature"`
+	TopP                  *float64            `db:"top_p"`
+	MaxOutputTokens       *int32              `db:"max_output_tokens"`
+	Seed                  *int64              `db:"seed"`
+	ReasoningEffort       *string             `db:"reasoning_effort"`
+	CallFilter            *CallFilter         `db:"call_filter"`
+	SamplePercent         *int32              `db:"sample_percent"`     // percent of the polled calls audited, nil audits all
+	AgentDailyLimit       *int32              `db:"agent_daily_limit"`  // jobs per agent per day
+	AgentWeeklyLimit      *int32              `db:"agent_weekly_limit"` // jobs per agent per week
+	DailyLimit            *int32              `db:"daily_limit"`        // jobs of the rule per day
+	Schedule              string              `db:"schedule"`           // cron spec, empty audits the calls as they are stored
+	Timezone              string              `db:"timezone"`           // of the domain, from join
+	Priority              int32               `db:"priority"`           // weight in the job scheduling of the domain
+	PausedReason          string              `db:"paused_reason"`      // why the enabled rule creates no jobs
+	Chunking              *TranscriptChunking `db:"chunking"`
+}
+
+// Rule priorities, the weight of the rule in the job scheduling of its domain.
+const (
+	RulePriorityDefault int32 = 1
+	RulePriorityMax     int32 = 100
+)
+
+// ChunkStrategy is how a transcript too long for a single request is audited.
+type ChunkStrategy string
+
+const (
+	// ChunkStrategyMapReduce audits the chunks of the dialogue and reduces their results.
+	ChunkStrategyMapReduce ChunkStrategy = "map_reduce"
+	// ChunkStrategyNone sends the whole dialogue in a single request.
+	ChunkStrategyNone ChunkStrategy = "none"
+)
+
+// Chunking defaults of a rule without the setting.
+const (
+	DefaultChunkTokens         = 12000
+	DefaultChunkOverlapPhrases = 3
+)
+
+// TranscriptChunking splits a long dialogue into overlapping chunks, zero or nil values are the defaults.
+type TranscriptChunking struct {
+	Strategy       ChunkStrategy `json:"strategy,omitempty"`
+	MaxTokens      int           `json:"max_tokens,omitempty"`      // estimated tokens of the dialogue and of a chunk
+	OverlapPhrases *int          `json:"overlap_phrases,omitempty"` // phrases repeated at the start of the next chunk, 0 repeats none
+}
+
+// CallFilter narrows the stored calls audited by a rule, an empty condition matches any call.
+type CallFilter struct {
+	Queues       []int64
+	Teams        []int64
+	Agents       []int64
+	Gateways     []int64
+	HangupCauses []string
+	Tags         []string
+	Destination  string // mask, * matches any characters and ? a single one
+	Number       string // mask of the from or the to number
+	Variables    map[string]string
+	MinDuration  int32 // total duration in seconds, 0 is no limit
+	MaxDuration  int32
+	TimeWindows  []CallTimeWindow // the call was placed within any of them, in the domain timezone
+}
+
+// CallTimeWindow is a time of the day on the days of the week.
+type CallTimeWindow struct {
+	Days        []int32 // ISO days of the week, 1 is Monday; every day when empty
+	StartMinute int32   // minutes since midnight, included
+	EndMinute   int32   // excluded
+}
+
+// JobState is the state of a call_audit.jobs row:
+// pending -> running -> succeeded | retry_scheduled | failed | dead, retry_scheduled -> running.
+// An operator cancels a pending, running or scheduled job and retries a failed, dead, cancelled or scheduled one.
+type JobState int
+
+const (
+	JobStatePending        JobState = 0
+	JobStateRunning        JobState = 1
+	JobStateSucceeded      JobState = 3
+	JobStateFailed         JobState = 4 // a failure that a retry does not fix
+	JobStateRetryScheduled JobState = 5
+	JobStateDead           JobState = 6 // a retryable failure that ran out of attempts
+	JobStateCancelled      JobState = 7
+)
+
+func (s JobState) String() string {
+	switch s {
+	case JobStatePending:
+		return "pending"
+	case JobStateRunning:
+		return "running"
+	case JobStateSucceeded:
+		return "succeeded"
+	case JobStateFailed:
+		return "failed"
+	case JobStateRetryScheduled:
+		return "retry_scheduled"
+	case JobStateDead:
+		return "dead"
+	case JobStateCancelled:
+		return "cancelled"
+	}
+	return fmt.Sprintf("state(%d)", int(s))
 }
 
 type CallJob struct {
-	ID           int64     `db:"id"`
-	RuleID       int64     `db:"rule_id"`
-	Type         int       `db:"type"`
-	Params       JobParams `db:"params"` // JSONB
-	State        int       `db:"state"`
-	CallStoredAt time.Time `db:"call_stored_at"`
+	ID           int64      `db:"id"`
+	RuleID       int64      `db:"rule_id"`
+	Type         int        `db:"type"`
+	Params       JobParams  `db:"params"` // JSONB
+	State        JobState   `db:"state"`
+	CallStoredAt time.Time  `db:"call_stored_at"`
+	Error        *string    `db:"error"`
+	Attempts     int        `db:"attempts"`
+	NextRunAt    *time.Time `db:"next_run_at"`
+	Priority     int        `db:"priority"`
 }
 
+// Job priorities, a job of a higher priority is claimed first.
+const (
+	JobPriorityDefault = 0
+	// JobPriorityManual is the priority of the calls a user has asked to audit.
+	JobPriorityManual = 100
+)
+
 type JobParams struct {
 	CallID          string     `json:"call_id" db:"call_id"`
+	DomainID        int64      `json:"domain_id" db:"domain_id"`
+	AgentID         *int64     `json:"agent_id,omitempty" db:"agent_id"`
 	FileID          int64      `json:"file_id" db:"file_id"`
 	Position        int        `json:"position" db:"position"`
 	StoredAt        time.Time  `json:"stored_at" db:"stored_at"`
@@ -55,6 +176,20 @@ type JobParams struct {
 	SaveExplanation *bool      `json:"save_explanation,omitempty"`
 	Variable        *string    `json:"variable,omitempty"`
 	Scorecard       int        `json:"scorecard,omitempty"`
+	// LLM provider resolved from the cognitive profile
+	Provider           *string `json:"provider,omitempty"`
+	ProviderURL        *string `json:"provider_url,omitempty"`
+	ProviderAPIVersion *string `json:"provider_api_version,omitempty"`
+	ProviderDeployment *string `json:"provider_deployment,omitempty"`
+	// generation parameters of the rule
+	Model           *string  `json:"model,omitempty"`
+	Temperature     *float64 `json:"temperature,omitempty"`
+	TopP            *float64 `json:"top_p,omitempty"`
+	MaxOutputTokens *int64   `json:"max_output_tokens,omitempty"`
+	Seed            *int64   `json:"seed,omitempty"`
+	ReasoningEffort *string  `json:"reasoning_effort,omitempty"`
+	// transcript chunking of the rule, nil is the default
+	Chunking *TranscriptChunking `json:"chunking,omitempty"`
 }
 
 type ScorecardForm struct {
@@ -0,0 +1,718 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: call_audit/audit_result.proto
+
+package call_audit
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Message: AuditScore
+// Answer of a single scorecard question, score is null for a skipped optional question
+type AuditScore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// zero based index of the scorecard question
+	Question      int32                  `protobuf:"varint,1,opt,name=question,proto3" json:"question,omitempty"`
+	Score         *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditScore) Reset() {
+	*x = AuditScore{}
+	mi := &file_call_audit_audit_result_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditScore) ProtoMessage() {}
+
+func (x *AuditScore) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_audit_result_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditScore.ProtoReflect.Descriptor instead.
+func (*AuditScore) Descriptor() ([]byte, []int) {
+	return file_call_audit_audit_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditScore) GetQuestion() int32 {
+	if x != nil {
+		return x.Question
+	}
+	return 0
+}
+
+func (x *AuditScore) GetScore() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+// Message: AuditUsage
+type AuditUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PromptTokens     int64                  `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,2,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	TotalTokens      int64                  `protobuf:"varint,3,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuditUsage) Reset() {
+	*x = AuditUsage{}
+	mi := &file_call_audit_audit_result_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditUsage) ProtoMessage() {}
+
+func (x *AuditUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_audit_result_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditUsage.ProtoReflect.Descriptor instead.
+func (*AuditUsage) Descriptor() ([]byte, []int) {
+	return file_call_audit_audit_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditUsage) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *AuditUsage) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *AuditUsage) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+// Message: AuditResult
+// Output of a processed call audit job
+type AuditResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId      int64                  `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	JobId         int64                  `protobuf:"varint,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Rule          *Lookup                `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	CallId        string                 `protobuf:"bytes,6,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	ScorecardId   int32                  `protobuf:"varint,7,opt,name=scorecard_id,json=scorecardId,proto3" json:"scorecard_id,omitempty"`
+	RateId        int64                  `protobuf:"varint,8,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,9,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model         string                 `protobuf:"bytes,10,opt,name=model,proto3" json:"model,omitempty"`
+	PromptVersion string                 `protobuf:"bytes,11,opt,name=prompt_version,json=promptVersion,proto3" json:"prompt_version,omitempty"`
+	RawResponse   string                 `protobuf:"bytes,12,opt,name=raw_response,json=rawResponse,proto3" json:"raw_response,omitempty"`
+	Scores        []*AuditScore          `protobuf:"bytes,13,rep,name=scores,proto3" json:"scores,omitempty"`
+	// sum of the answered scores
+	Score         *wrapperspb.Int32Value `protobuf:"bytes,14,opt,name=score,proto3" json:"score,omitempty"`
+	Summary       string                 `protobuf:"bytes,15,opt,name=summary,proto3" json:"summary,omitempty"`
+	Category      string                 `protobuf:"bytes,16,opt,name=category,proto3" json:"category,omitempty"`
+	Usage         *AuditUsage            `protobuf:"bytes,17,opt,name=usage,proto3" json:"usage,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,18,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditResult) Reset() {
+	*x = AuditResult{}
+	mi := &file_call_audit_audit_result_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResult) ProtoMessage() {}
+
+func (x *AuditResult) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_audit_result_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResult.ProtoReflect.Descriptor instead.
+func (*AuditResult) Descriptor() ([]byte, []int) {
+	return file_call_audit_audit_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuditResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditResult) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *AuditResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditResult) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *AuditResult) GetRule() *Lookup {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *AuditResult) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *AuditResult) GetScorecardId() int32 {
+	if x != nil {
+		return x.ScorecardId
+	}
+	return 0
+}
+
+func (x *AuditResult) GetRateId() int64 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *AuditResult) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AuditResult) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AuditResult) GetPromptVersion() string {
+	if x != nil {
+		return x.PromptVersion
+	}
+	return ""
+}
+
+func (x *AuditResult) GetRawResponse() string {
+	if x != nil {
+		return x.RawResponse
+	}
+	return ""
+}
+
+func (x *AuditResult) GetScores() []*AuditScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *AuditResult) GetScore() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *AuditResult) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *AuditResult) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AuditResult) GetUsage() *AuditUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *AuditResult) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+// Message: AuditResultList
+type AuditResultList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AuditResult         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditResultList) Reset() {
+	*x = AuditResultList{}
+	mi := &file_call_audit_audit_result_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditResultList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResultList) ProtoMessage() {}
+
+func (x *AuditResultList) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_audit_result_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResultList.ProtoReflect.Descriptor instead.
+func (*AuditResultList) Descriptor() ([]byte, []int) {
+	return file_call_audit_audit_result_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditResultList) GetItems() []*AuditResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AuditResultList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AuditResultList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+// Message: GetAuditResultRequest
+type GetAuditResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditResultRequest) Reset() {
+	*x = GetAuditResultRequest{}
+	mi := &file_call_audit_audit_result_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditResultRequest) ProtoMessage() {}
+
+func (x *GetAuditResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_audit_result_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditResultRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditResultRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_audit_result_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAuditResultRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetAuditResultRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: SearchAuditResultsRequest
+type SearchAuditResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Q             string                 `protobuf:"bytes,3,opt,name=q,proto3" json:"q,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Fields        []string               `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Id            []int64                `protobuf:"varint,6,rep,packed,name=id,proto3" json:"id,omitempty"`
+	CallId        string                 `protobuf:"bytes,7,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	RuleId        []int64                `protobuf:"varint,8,rep,packed,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Category      []string               `protobuf:"bytes,11,rep,name=category,proto3" json:"category,omitempty"`
+	ScoreFrom     *wrapperspb.Int32Value `protobuf:"bytes,12,opt,name=score_from,json=scoreFrom,proto3" json:"score_from,omitempty"`
+	ScoreTo       *wrapperspb.Int32Value `protobuf:"bytes,13,opt,name=score_to,json=scoreTo,proto3" json:"score_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditResultsRequest) Reset() {
+	*x = SearchAuditResultsRequest{}
+	mi := &file_call_audit_audit_result_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditResultsRequest) ProtoMessage() {}
+
+func (x *SearchAuditResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_audit_result_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditResultsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAuditResultsRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_audit_result_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchAuditResultsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchAuditResultsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchAuditResultsRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchAuditResultsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchAuditResultsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *SearchAuditResultsRequest) GetRuleId() []int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetCategory() []string {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetScoreFrom() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ScoreFrom
+	}
+	return nil
+}
+
+func (x *SearchAuditResultsRequest) GetScoreTo() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ScoreTo
+	}
+	return nil
+}
+
+var File_call_audit_audit_result_proto protoreflect.FileDescriptor
+
+const file_call_audit_audit_result_proto_rawDesc = "" +
+	"\n" +
+	"\x1dcall_audit/audit_result.proto\x12\n" +
+	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x18call_audit/general.proto\x1a\x18call_audit/options.proto\"[\n" +
+	"\n" +
+	"AuditScore\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\x05R\bquestion\x121\n" +
+	"\x05score\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05score\"\x81\x01\n" +
+	"\n" +
+	"AuditUsage\x12#\n" +
+	"\rprompt_tokens\x18\x01 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x02 \x01(\x03R\x10completionTokens\x12!\n" +
+	"\ftotal_tokens\x18\x03 \x01(\x03R\vtotalTokens\"\xeb\x04\n" +
+	"\vAuditResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\x03R\bdomainId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\x03R\x05jobId\x12&\n" +
+	"\x04rule\x18\x05 \x01(\v2\x12.call_audit.LookupR\x04rule\x12\x17\n" +
+	"\acall_id\x18\x06 \x01(\tR\x06callId\x12!\n" +
+	"\fscorecard_id\x18\a \x01(\x05R\vscorecardId\x12\x17\n" +
+	"\arate_id\x18\b \x01(\x03R\x06rateId\x12\x1a\n" +
+	"\bprovider\x18\t \x01(\tR\bprovider\x12\x14\n" +
+	"\x05model\x18\n" +
+	" \x01(\tR\x05model\x12%\n" +
+	"\x0eprompt_version\x18\v \x01(\tR\rpromptVersion\x12!\n" +
+	"\fraw_response\x18\f \x01(\tR\vrawResponse\x12.\n" +
+	"\x06scores\x18\r \x03(\v2\x16.call_audit.AuditScoreR\x06scores\x121\n" +
+	"\x05score\x18\x0e \x01(\v2\x1b.google.protobuf.Int32ValueR\x05score\x12\x18\n" +
+	"\asummary\x18\x0f \x01(\tR\asummary\x12\x1a\n" +
+	"\bcategory\x18\x10 \x01(\tR\bcategory\x12,\n" +
+	"\x05usage\x18\x11 \x01(\v2\x16.call_audit.AuditUsageR\x05usage\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x12 \x01(\x03R\tlatencyMs\"h\n" +
+	"\x0fAuditResultList\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.call_audit.AuditResultR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x03 \x01(\bR\x04next\"?\n" +
+	"\x15GetAuditResultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"\xc9\x03\n" +
+	"\x19SearchAuditResultsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\f\n" +
+	"\x01q\x18\x03 \x01(\tR\x01q\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\x12\x0e\n" +
+	"\x02id\x18\x06 \x03(\x03R\x02id\x12\x17\n" +
+	"\acall_id\x18\a \x01(\tR\x06callId\x12\x17\n" +
+	"\arule_id\x18\b \x03(\x03R\x06ruleId\x12=\n" +
+	"\fcreated_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1a\n" +
+	"\bcategory\x18\v \x03(\tR\bcategory\x12:\n" +
+	"\n" +
+	"score_from\x18\f \x01(\v2\x1b.google.protobuf.Int32ValueR\tscoreFrom\x126\n" +
+	"\bscore_to\x18\r \x01(\v2\x1b.google.protobuf.Int32ValueR\ascoreTo2\xc9\x01\n" +
+	"\x12AuditResultService\x12R\n" +
+	"\x06Search\x12%.call_audit.SearchAuditResultsRequest\x1a\x1b.call_audit.AuditResultList\"\x04\x88\xb5\x18\x01\x12G\n" +
+	"\x03Get\x12!.call_audit.GetAuditResultRequest\x1a\x17.call_audit.AuditResult\"\x04\x88\xb5\x18\x01\x1a\x16\x8a\xb5\x18\x12call_audit_resultsB\x94\x01\n" +
+	"\x0ecom.call_auditB\x10AuditResultProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+
+var (
+	file_call_audit_audit_result_proto_rawDescOnce sync.Once
+	file_call_audit_audit_result_proto_rawDescData []byte
+)
+
+func file_call_audit_audit_result_proto_rawDescGZIP() []byte {
+	file_call_audit_audit_result_proto_rawDescOnce.Do(func() {
+		file_call_audit_audit_result_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_call_audit_audit_result_proto_rawDesc), len(file_call_audit_audit_result_proto_rawDesc)))
+	})
+	return file_call_audit_audit_result_proto_rawDescData
+}
+
+var file_call_audit_audit_result_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_call_audit_audit_result_proto_goTypes = []any{
+	(*AuditScore)(nil),                // 0: call_audit.AuditScore
+	(*AuditUsage)(nil),                // 1: call_audit.AuditUsage
+	(*AuditResult)(nil),               // 2: call_audit.AuditResult
+	(*AuditResultList)(nil),           // 3: call_audit.AuditResultList
+	(*GetAuditResultRequest)(nil),     // 4: call_audit.GetAuditResultRequest
+	(*SearchAuditResultsRequest)(nil), // 5: call_audit.SearchAuditResultsRequest
+	(*wrapperspb.Int32Value)(nil),     // 6: google.protobuf.Int32Value
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+	(*Lookup)(nil),                    // 8: call_audit.Lookup
+}
+var file_call_audit_audit_result_proto_depIdxs = []int32{
+	6,  // 0: call_audit.AuditScore.score:type_name -> google.protobuf.Int32Value
+	7,  // 1: call_audit.AuditResult.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: call_audit.AuditResult.rule:type_name -> call_audit.Lookup
+	0,  // 3: call_audit.AuditResult.scores:type_name -> call_audit.AuditScore
+	6,  // 4: call_audit.AuditResult.score:type_name -> google.protobuf.Int32Value
+	1,  // 5: call_audit.AuditResult.usage:type_name -> call_audit.AuditUsage
+	2,  // 6: call_audit.AuditResultList.items:type_name -> call_audit.AuditResult
+	7,  // 7: call_audit.SearchAuditResultsRequest.created_from:type_name -> google.protobuf.Timestamp
+	7,  // 8: call_audit.SearchAuditResultsRequest.created_to:type_name -> google.protobuf.Timestamp
+	6,  // 9: call_audit.SearchAuditResultsRequest.score_from:type_name -> google.protobuf.Int32Value
+	6,  // 10: call_audit.SearchAuditResultsRequest.score_to:type_name -> google.protobuf.Int32Value
+	5,  // 11: call_audit.AuditResultService.Search:input_type -> call_audit.SearchAuditResultsRequest
+	4,  // 12: call_audit.AuditResultService.Get:input_type -> call_audit.GetAuditResultRequest
+	3,  // 13: call_audit.AuditResultService.Search:output_type -> call_audit.AuditResultList
+	2,  // 14: call_audit.AuditResultService.Get:output_type -> call_audit.AuditResult
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_call_audit_audit_result_proto_init() }
+func file_call_audit_audit_result_proto_init() {
+	if File_call_audit_audit_result_proto != nil {
+		return
+	}
+	file_call_audit_general_proto_init()
+	file_call_audit_options_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_audit_audit_result_proto_rawDesc), len(file_call_audit_audit_result_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_call_audit_audit_result_proto_goTypes,
+		DependencyIndexes: file_call_audit_audit_result_proto_depIdxs,
+		MessageInfos:      file_call_audit_audit_result_proto_msgTypes,
+	}.Build()
+	File_call_audit_audit_result_proto = out.File
+	file_call_audit_audit_result_proto_goTypes = nil
+	file_call_audit_audit_result_proto_depIdxs = nil
+}
@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: call_audit/backfill.proto
+
+package call_audit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BackfillService_Create_FullMethodName = "/call_audit.BackfillService/Create"
+	BackfillService_Search_FullMethodName = "/call_audit.BackfillService/Search"
+	BackfillService_Get_FullMethodName    = "/call_audit.BackfillService/Get"
+	BackfillService_Pause_FullMethodName  = "/call_audit.BackfillService/Pause"
+	BackfillService_Resume_FullMethodName = "/call_audit.BackfillService/Resume"
+	BackfillService_Cancel_FullMethodName = "/call_audit.BackfillService/Cancel"
+)
+
+// BackfillServiceClient is the client API for BackfillService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service definition
+type BackfillServiceClient interface {
+	Create(ctx context.Context, in *CreateBackfillRequest, opts ...grpc.CallOption) (*Backfill, error)
+	Search(ctx context.Context, in *SearchBackfillsRequest, opts ...grpc.CallOption) (*BackfillList, error)
+	Get(ctx context.Context, in *GetBackfillRequest, opts ...grpc.CallOption) (*Backfill, error)
+	Pause(ctx context.Context, in *PauseBackfillRequest, opts ...grpc.CallOption) (*Backfill, error)
+	Resume(ctx context.Context, in *ResumeBackfillRequest, opts ...grpc.CallOption) (*Backfill, error)
+	Cancel(ctx context.Context, in *CancelBackfillRequest, opts ...grpc.CallOption) (*Backfill, error)
+}
+
+type backfillServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackfillServiceClient(cc grpc.ClientConnInterface) BackfillServiceClient {
+	return &backfillServiceClient{cc}
+}
+
+func (c *backfillServiceClient) Create(ctx context.Context, in *CreateBackfillRequest, opts ...grpc.CallOption) (*Backfill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backfill)
+	err := c.cc.Invoke(ctx, BackfillService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backfillServiceClient) Search(ctx context.Context, in *SearchBackfillsRequest, opts ...grpc.CallOption) (*BackfillList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackfillList)
+	err := c.cc.Invoke(ctx, BackfillService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backfillServiceClient) Get(ctx context.Context, in *GetBackfillRequest, opts ...grpc.CallOption) (*Backfill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backfill)
+	err := c.cc.Invoke(ctx, BackfillService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backfillServiceClient) Pause(ctx context.Context, in *PauseBackfillRequest, opts ...grpc.CallOption) (*Backfill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backfill)
+	err := c.cc.Invoke(ctx, BackfillService_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backfillServiceClient) Resume(ctx context.Context, in *ResumeBackfillRequest, opts ...grpc.CallOption) (*Backfill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backfill)
+	err := c.cc.Invoke(ctx, BackfillService_Resume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backfillServiceClient) Cancel(ctx context.Context, in *CancelBackfillRequest, opts ...grpc.CallOption) (*Backfill, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Backfill)
+	err := c.cc.Invoke(ctx, BackfillService_Cancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackfillServiceServer is the server API for BackfillService service.
+// All implementations must embed UnimplementedBackfillServiceServer
+// for forward compatibility.
+//
+// Service definition
+type BackfillServiceServer interface {
+	Create(context.Context, *CreateBackfillRequest) (*Backfill, error)
+	Search(context.Context, *SearchBackfillsRequest) (*BackfillList, error)
+	Get(context.Context, *GetBackfillRequest) (*Backfill, error)
+	Pause(context.Context, *PauseBackfillRequest) (*Backfill, error)
+	Resume(context.Context, *ResumeBackfillRequest) (*Backfill, error)
+	Cancel(context.Context, *CancelBackfillRequest) (*Backfill, error)
+	mustEmbedUnimplementedBackfillServiceServer()
+}
+
+// UnimplementedBackfillServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBackfillServiceServer struct{}
+
+func (UnimplementedBackfillServiceServer) Create(context.Context, *CreateBackfillRequest) (*Backfill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedBackfillServiceServer) Search(context.Context, *SearchBackfillsRequest) (*BackfillList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedBackfillServiceServer) Get(context.Context, *GetBackfillRequest) (*Backfill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedBackfillServiceServer) Pause(context.Context, *PauseBackfillRequest) (*Backfill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedBackfillServiceServer) Resume(context.Context, *ResumeBackfillRequest) (*Backfill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedBackfillServiceServer) Cancel(context.Context, *CancelBackfillRequest) (*Backfill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedBackfillServiceServer) mustEmbedUnimplementedBackfillServiceServer() {}
+func (UnimplementedBackfillServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeBackfillServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackfillServiceServer will
+// result in compilation errors.
+type UnsafeBackfillServiceServer interface {
+	mustEmbedUnimplementedBackfillServiceServer()
+}
+
+func RegisterBackfillServiceServer(s grpc.ServiceRegistrar, srv BackfillServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBackfillServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BackfillService_ServiceDesc, srv)
+}
+
+func _BackfillService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackfillServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackfillService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackfillServiceServer).Create(ctx, req.(*CreateBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackfillService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBackfillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackfillServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackfillService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackfillServiceServer).Search(ctx, req.(*SearchBackfillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackfillService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackfillServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackfillService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackfillServiceServer).Get(ctx, req.(*GetBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackfillService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackfillServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackfillService_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackfillServiceServer).Pause(ctx, req.(*PauseBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackfillService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackfillServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackfillService_Resume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackfillServiceServer).Resume(ctx, req.(*ResumeBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackfillService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackfillServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackfillService_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackfillServiceServer).Cancel(ctx, req.(*CancelBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BackfillService_ServiceDesc is the grpc.ServiceDesc for BackfillService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BackfillService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "call_audit.BackfillService",
+	HandlerType: (*BackfillServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _BackfillService_Create_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _BackfillService_Search_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _BackfillService_Get_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _BackfillService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _BackfillService_Resume_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _BackfillService_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "call_audit/backfill.proto",
+}
@@ -146,8 +146,8 @@ const file_call_audit_general_proto_rawDesc = "" +
 	"\x0eExtendedLookup\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04typeB\x96\x01\n" +
-	"\x0ecom.call_auditB\fGeneralProtoP\x01Z2github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+	"\x04type\x18\x03 \x01(\tR\x04typeB\x90\x01\n" +
+	"\x0ecom.call_auditB\fGeneralProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
 
 var (
 	file_call_audit_general_proto_rawDescOnce sync.Once
@@ -0,0 +1,994 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: call_audit/job.proto
+
+package call_audit
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enum: JobState
+// pending -> running -> succeeded | retry_scheduled | failed | dead, retry_scheduled -> running
+type JobState int32
+
+const (
+	JobState_JOB_STATE_PENDING   JobState = 0
+	JobState_JOB_STATE_RUNNING   JobState = 1
+	JobState_JOB_STATE_SUCCEEDED JobState = 3
+	// a failure that a retry does not fix
+	JobState_JOB_STATE_FAILED          JobState = 4
+	JobState_JOB_STATE_RETRY_SCHEDULED JobState = 5
+	// a retryable failure that ran out of attempts
+	JobState_JOB_STATE_DEAD JobState = 6
+	// cancelled by an operator
+	JobState_JOB_STATE_CANCELLED JobState = 7
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "JOB_STATE_PENDING",
+		1: "JOB_STATE_RUNNING",
+		3: "JOB_STATE_SUCCEEDED",
+		4: "JOB_STATE_FAILED",
+		5: "JOB_STATE_RETRY_SCHEDULED",
+		6: "JOB_STATE_DEAD",
+		7: "JOB_STATE_CANCELLED",
+	}
+	JobState_value = map[string]int32{
+		"JOB_STATE_PENDING":         0,
+		"JOB_STATE_RUNNING":         1,
+		"JOB_STATE_SUCCEEDED":       3,
+		"JOB_STATE_FAILED":          4,
+		"JOB_STATE_RETRY_SCHEDULED": 5,
+		"JOB_STATE_DEAD":            6,
+		"JOB_STATE_CANCELLED":       7,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_call_audit_job_proto_enumTypes[0].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_call_audit_job_proto_enumTypes[0]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{0}
+}
+
+// Message: Job
+// Audit of a single call by a rule
+type Job struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule     *Lookup                `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	CallId   string                 `protobuf:"bytes,3,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	State    JobState               `protobuf:"varint,4,opt,name=state,proto3,enum=call_audit.JobState" json:"state,omitempty"`
+	Attempts int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last failure reason
+	Error     string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// instance processing the job
+	LockedBy      string                 `protobuf:"bytes,10,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	LeaseUntil    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=lease_until,json=leaseUntil,proto3" json:"lease_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_call_audit_job_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Job) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Job) GetRule() *Lookup {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *Job) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *Job) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_PENDING
+}
+
+func (x *Job) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Job) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Job) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+func (x *Job) GetLeaseUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeaseUntil
+	}
+	return nil
+}
+
+// Message: JobList
+type JobList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Job                 `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobList) Reset() {
+	*x = JobList{}
+	mi := &file_call_audit_job_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobList) ProtoMessage() {}
+
+func (x *JobList) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobList.ProtoReflect.Descriptor instead.
+func (*JobList) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobList) GetItems() []*Job {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *JobList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *JobList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+// Message: SearchJobsRequest
+type SearchJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Fields        []string               `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Id            []int64                `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	RuleId        []int64                `protobuf:"varint,6,rep,packed,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	State         []JobState             `protobuf:"varint,7,rep,packed,name=state,proto3,enum=call_audit.JobState" json:"state,omitempty"`
+	CallId        string                 `protobuf:"bytes,8,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJobsRequest) Reset() {
+	*x = SearchJobsRequest{}
+	mi := &file_call_audit_job_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJobsRequest) ProtoMessage() {}
+
+func (x *SearchJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJobsRequest.ProtoReflect.Descriptor instead.
+func (*SearchJobsRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchJobsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchJobsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchJobsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchJobsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchJobsRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SearchJobsRequest) GetRuleId() []int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *SearchJobsRequest) GetState() []JobState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *SearchJobsRequest) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *SearchJobsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *SearchJobsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+// Message: GetJobRequest
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_call_audit_job_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetJobRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: RetryJobRequest
+// Runs a failed, dead, cancelled or scheduled job again from the first attempt
+type RetryJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryJobRequest) Reset() {
+	*x = RetryJobRequest{}
+	mi := &file_call_audit_job_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryJobRequest) ProtoMessage() {}
+
+func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryJobRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RetryJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RetryJobRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: CancelJobRequest
+// Stops a pending, running or scheduled job, a running audit is not interrupted but the job stays cancelled
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_call_audit_job_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelJobRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Message: RetryFailedJobsRequest
+// Runs all failed and dead jobs of the rule again
+type RetryFailedJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedJobsRequest) Reset() {
+	*x = RetryFailedJobsRequest{}
+	mi := &file_call_audit_job_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedJobsRequest) ProtoMessage() {}
+
+func (x *RetryFailedJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedJobsRequest.ProtoReflect.Descriptor instead.
+func (*RetryFailedJobsRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RetryFailedJobsRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+// Message: RetryFailedJobsResponse
+type RetryFailedJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// number of jobs scheduled again
+	Count         int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedJobsResponse) Reset() {
+	*x = RetryFailedJobsResponse{}
+	mi := &file_call_audit_job_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedJobsResponse) ProtoMessage() {}
+
+func (x *RetryFailedJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedJobsResponse.ProtoReflect.Descriptor instead.
+func (*RetryFailedJobsResponse) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetryFailedJobsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Message: AuditCallsRequest
+// Audits the calls with the rule right now, regardless of the rule's duration, direction and time window
+// A rule paused by the budget of the domain is rejected
+type AuditCallsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RuleId int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	CallId []string               `protobuf:"bytes,2,rep,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	// rule settings used instead of the saved ones, only the fields listed in x_json_mask:
+	// scorecard, default_promt, save_explanation, variable, model, temperature, top_p,
+	// max_output_tokens, seed, reasoning_effort
+	Overrides     *CallQuestionnaireRule `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	XJsonMask     []string               `protobuf:"bytes,4,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditCallsRequest) Reset() {
+	*x = AuditCallsRequest{}
+	mi := &file_call_audit_job_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditCallsRequest) ProtoMessage() {}
+
+func (x *AuditCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditCallsRequest.ProtoReflect.Descriptor instead.
+func (*AuditCallsRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuditCallsRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *AuditCallsRequest) GetCallId() []string {
+	if x != nil {
+		return x.CallId
+	}
+	return nil
+}
+
+func (x *AuditCallsRequest) GetOverrides() *CallQuestionnaireRule {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *AuditCallsRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+// Message: AuditCallJob
+type AuditCallJob struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CallId string                 `protobuf:"bytes,1,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	// 0 when the call is not found or has no recording
+	JobId         int64 `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditCallJob) Reset() {
+	*x = AuditCallJob{}
+	mi := &file_call_audit_job_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditCallJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditCallJob) ProtoMessage() {}
+
+func (x *AuditCallJob) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditCallJob.ProtoReflect.Descriptor instead.
+func (*AuditCallJob) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuditCallJob) GetCallId() string {
+	if x != nil {
+		return x.CallId
+	}
+	return ""
+}
+
+func (x *AuditCallJob) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+// Message: AuditCallsResponse
+type AuditCallsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AuditCallJob        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditCallsResponse) Reset() {
+	*x = AuditCallsResponse{}
+	mi := &file_call_audit_job_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditCallsResponse) ProtoMessage() {}
+
+func (x *AuditCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_job_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditCallsResponse.ProtoReflect.Descriptor instead.
+func (*AuditCallsResponse) Descriptor() ([]byte, []int) {
+	return file_call_audit_job_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AuditCallsResponse) GetItems() []*AuditCallJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_call_audit_job_proto protoreflect.FileDescriptor
+
+const file_call_audit_job_proto_rawDesc = "" +
+	"\n" +
+	"\x14call_audit/job.proto\x12\n" +
+	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18call_audit/general.proto\x1a(call_audit/call_questionnaire_rule.proto\x1a\x18call_audit/options.proto\"\xc0\x03\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x04rule\x18\x02 \x01(\v2\x12.call_audit.LookupR\x04rule\x12\x17\n" +
+	"\acall_id\x18\x03 \x01(\tR\x06callId\x12*\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x14.call_audit.JobStateR\x05state\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12:\n" +
+	"\vnext_run_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tlocked_by\x18\n" +
+	" \x01(\tR\blockedBy\x12;\n" +
+	"\vlease_until\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"leaseUntil\"X\n" +
+	"\aJobList\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.call_audit.JobR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x03 \x01(\bR\x04next\"\xcf\x02\n" +
+	"\x11SearchJobsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x12\x0e\n" +
+	"\x02id\x18\x05 \x03(\x03R\x02id\x12\x17\n" +
+	"\arule_id\x18\x06 \x03(\x03R\x06ruleId\x12*\n" +
+	"\x05state\x18\a \x03(\x0e2\x14.call_audit.JobStateR\x05state\x12\x17\n" +
+	"\acall_id\x18\b \x01(\tR\x06callId\x12=\n" +
+	"\fcreated_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\"7\n" +
+	"\rGetJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"9\n" +
+	"\x0fRetryJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\":\n" +
+	"\x10CancelJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\"1\n" +
+	"\x16RetryFailedJobsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"/\n" +
+	"\x17RetryFailedJobsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\xa6\x01\n" +
+	"\x11AuditCallsRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12\x17\n" +
+	"\acall_id\x18\x02 \x03(\tR\x06callId\x12?\n" +
+	"\toverrides\x18\x03 \x01(\v2!.call_audit.CallQuestionnaireRuleR\toverrides\x12\x1e\n" +
+	"\vx_json_mask\x18\x04 \x03(\tR\txJsonMask\">\n" +
+	"\fAuditCallJob\x12\x17\n" +
+	"\acall_id\x18\x01 \x01(\tR\x06callId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x03R\x05jobId\"D\n" +
+	"\x12AuditCallsResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.call_audit.AuditCallJobR\x05items*\xb3\x01\n" +
+	"\bJobState\x12\x15\n" +
+	"\x11JOB_STATE_PENDING\x10\x00\x12\x15\n" +
+	"\x11JOB_STATE_RUNNING\x10\x01\x12\x17\n" +
+	"\x13JOB_STATE_SUCCEEDED\x10\x03\x12\x14\n" +
+	"\x10JOB_STATE_FAILED\x10\x04\x12\x1d\n" +
+	"\x19JOB_STATE_RETRY_SCHEDULED\x10\x05\x12\x12\n" +
+	"\x0eJOB_STATE_DEAD\x10\x06\x12\x17\n" +
+	"\x13JOB_STATE_CANCELLED\x10\a2\xcb\x03\n" +
+	"\n" +
+	"JobService\x12B\n" +
+	"\x06Search\x12\x1d.call_audit.SearchJobsRequest\x1a\x13.call_audit.JobList\"\x04\x88\xb5\x18\x01\x127\n" +
+	"\x03Get\x12\x19.call_audit.GetJobRequest\x1a\x0f.call_audit.Job\"\x04\x88\xb5\x18\x01\x12;\n" +
+	"\x05Retry\x12\x1b.call_audit.RetryJobRequest\x1a\x0f.call_audit.Job\"\x04\x88\xb5\x18\x02\x12=\n" +
+	"\x06Cancel\x12\x1c.call_audit.CancelJobRequest\x1a\x0f.call_audit.Job\"\x04\x88\xb5\x18\x02\x12\\\n" +
+	"\vRetryFailed\x12\".call_audit.RetryFailedJobsRequest\x1a#.call_audit.RetryFailedJobsResponse\"\x04\x88\xb5\x18\x02\x12Q\n" +
+	"\n" +
+	"AuditCalls\x12\x1d.call_audit.AuditCallsRequest\x1a\x1e.call_audit.AuditCallsResponse\"\x04\x88\xb5\x18\x00\x1a\x13\x8a\xb5\x18\x0fcall_audit_jobsB\x8c\x01\n" +
+	"\x0ecom.call_auditB\bJobProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+
+var (
+	file_call_audit_job_proto_rawDescOnce sync.Once
+	file_call_audit_job_proto_rawDescData []byte
+)
+
+func file_call_audit_job_proto_rawDescGZIP() []byte {
+	file_call_audit_job_proto_rawDescOnce.Do(func() {
+		file_call_audit_job_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_call_audit_job_proto_rawDesc), len(file_call_audit_job_proto_rawDesc)))
+	})
+	return file_call_audit_job_proto_rawDescData
+}
+
+var file_call_audit_job_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_call_audit_job_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_call_audit_job_proto_goTypes = []any{
+	(JobState)(0),                   // 0: call_audit.JobState
+	(*Job)(nil),                     // 1: call_audit.Job
+	(*JobList)(nil),                 // 2: call_audit.JobList
+	(*SearchJobsRequest)(nil),       // 3: call_audit.SearchJobsRequest
+	(*GetJobRequest)(nil),           // 4: call_audit.GetJobRequest
+	(*RetryJobRequest)(nil),         // 5: call_audit.RetryJobRequest
+	(*CancelJobRequest)(nil),        // 6: call_audit.CancelJobRequest
+	(*RetryFailedJobsRequest)(nil),  // 7: call_audit.RetryFailedJobsRequest
+	(*RetryFailedJobsResponse)(nil), // 8: call_audit.RetryFailedJobsResponse
+	(*AuditCallsRequest)(nil),       // 9: call_audit.AuditCallsRequest
+	(*AuditCallJob)(nil),            // 10: call_audit.AuditCallJob
+	(*AuditCallsResponse)(nil),      // 11: call_audit.AuditCallsResponse
+	(*Lookup)(nil),                  // 12: call_audit.Lookup
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+	(*CallQuestionnaireRule)(nil),   // 14: call_audit.CallQuestionnaireRule
+}
+var file_call_audit_job_proto_depIdxs = []int32{
+	12, // 0: call_audit.Job.rule:type_name -> call_audit.Lookup
+	0,  // 1: call_audit.Job.state:type_name -> call_audit.JobState
+	13, // 2: call_audit.Job.next_run_at:type_name -> google.protobuf.Timestamp
+	13, // 3: call_audit.Job.created_at:type_name -> google.protobuf.Timestamp
+	13, // 4: call_audit.Job.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 5: call_audit.Job.lease_until:type_name -> google.protobuf.Timestamp
+	1,  // 6: call_audit.JobList.items:type_name -> call_audit.Job
+	0,  // 7: call_audit.SearchJobsRequest.state:type_name -> call_audit.JobState
+	13, // 8: call_audit.SearchJobsRequest.created_from:type_name -> google.protobuf.Timestamp
+	13, // 9: call_audit.SearchJobsRequest.created_to:type_name -> google.protobuf.Timestamp
+	14, // 10: call_audit.AuditCallsRequest.overrides:type_name -> call_audit.CallQuestionnaireRule
+	10, // 11: call_audit.AuditCallsResponse.items:type_name -> call_audit.AuditCallJob
+	3,  // 12: call_audit.JobService.Search:input_type -> call_audit.SearchJobsRequest
+	4,  // 13: call_audit.JobService.Get:input_type -> call_audit.GetJobRequest
+	5,  // 14: call_audit.JobService.Retry:input_type -> call_audit.RetryJobRequest
+	6,  // 15: call_audit.JobService.Cancel:input_type -> call_audit.CancelJobRequest
+	7,  // 16: call_audit.JobService.RetryFailed:input_type -> call_audit.RetryFailedJobsRequest
+	9,  // 17: call_audit.JobService.AuditCalls:input_type -> call_audit.AuditCallsRequest
+	2,  // 18: call_audit.JobService.Search:output_type -> call_audit.JobList
+	1,  // 19: call_audit.JobService.Get:output_type -> call_audit.Job
+	1,  // 20: call_audit.JobService.Retry:output_type -> call_audit.Job
+	1,  // 21: call_audit.JobService.Cancel:output_type -> call_audit.Job
+	8,  // 22: call_audit.JobService.RetryFailed:output_type -> call_audit.RetryFailedJobsResponse
+	11, // 23: call_audit.JobService.AuditCalls:output_type -> call_audit.AuditCallsResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_call_audit_job_proto_init() }
+func file_call_audit_job_proto_init() {
+	if File_call_audit_job_proto != nil {
+		return
+	}
+	file_call_audit_general_proto_init()
+	file_call_audit_call_questionnaire_rule_proto_init()
+	file_call_audit_options_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_audit_job_proto_rawDesc), len(file_call_audit_job_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_call_audit_job_proto_goTypes,
+		DependencyIndexes: file_call_audit_job_proto_depIdxs,
+		EnumInfos:         file_call_audit_job_proto_enumTypes,
+		MessageInfos:      file_call_audit_job_proto_msgTypes,
+	}.Build()
+	File_call_audit_job_proto = out.File
+	file_call_audit_job_proto_goTypes = nil
+	file_call_audit_job_proto_depIdxs = nil
+}
@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: call_audit/usage.proto
+
+package call_audit
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Message: Usage
+// LLM usage of a rule and a model in a day, previews are counted without a rule
+type Usage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UTC day, e.g. "2026-01-31"
+	Day   string  `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Rule  *Lookup `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Model string  `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// chat completions made
+	Requests         int64 `protobuf:"varint,4,opt,name=requests,proto3" json:"requests,omitempty"`
+	PromptTokens     int64 `protobuf:"varint,5,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64 `protobuf:"varint,6,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	// in the currency of the price table, 0 for a model without a price
+	Cost          float64 `protobuf:"fixed64,7,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Usage) Reset() {
+	*x = Usage{}
+	mi := &file_call_audit_usage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usage) ProtoMessage() {}
+
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Usage) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *Usage) GetRule() *Lookup {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *Usage) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Usage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *Usage) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+// Message: UsageList
+type UsageList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Usage               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageList) Reset() {
+	*x = UsageList{}
+	mi := &file_call_audit_usage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageList) ProtoMessage() {}
+
+func (x *UsageList) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageList.ProtoReflect.Descriptor instead.
+func (*UsageList) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UsageList) GetItems() []*Usage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UsageList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *UsageList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+// Message: SearchUsageRequest
+// Newest day first
+type SearchUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	RuleId        []int64                `protobuf:"varint,3,rep,packed,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsageRequest) Reset() {
+	*x = SearchUsageRequest{}
+	mi := &file_call_audit_usage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsageRequest) ProtoMessage() {}
+
+func (x *SearchUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsageRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsageRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchUsageRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsageRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchUsageRequest) GetRuleId() []int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *SearchUsageRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SearchUsageRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Message: Budget
+// Monthly LLM budget of the domain, the enabled rules are paused while the spent cost reaches the limit.
+// Manual audits and backfills of a paused rule are rejected, the queued jobs are finished
+type Budget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 is no budget
+	MonthlyLimit float64 `protobuf:"fixed64,1,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	// cost of the current UTC calendar month
+	Spent         float64                `protobuf:"fixed64,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	Exhausted     bool                   `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     *Lookup                `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_call_audit_usage_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Budget) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+func (x *Budget) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *Budget) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+func (x *Budget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Budget) GetUpdatedBy() *Lookup {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+// Message: GetBudgetRequest
+type GetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetRequest) Reset() {
+	*x = GetBudgetRequest{}
+	mi := &file_call_audit_usage_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetRequest) ProtoMessage() {}
+
+func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{4}
+}
+
+// Message: SetBudgetRequest
+type SetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthlyLimit  float64                `protobuf:"fixed64,1,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetRequest) Reset() {
+	*x = SetBudgetRequest{}
+	mi := &file_call_audit_usage_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetRequest) ProtoMessage() {}
+
+func (x *SetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetBudgetRequest) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+// Message: DeleteBudgetRequest
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_call_audit_usage_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_call_audit_usage_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_call_audit_usage_proto_rawDescGZIP(), []int{6}
+}
+
+var File_call_audit_usage_proto protoreflect.FileDescriptor
+
+const file_call_audit_usage_proto_rawDesc = "" +
+	"\n" +
+	"\x16call_audit/usage.proto\x12\n" +
+	"call_audit\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18call_audit/general.proto\x1a\x18call_audit/options.proto\"\xd9\x01\n" +
+	"\x05Usage\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12&\n" +
+	"\x04rule\x18\x02 \x01(\v2\x12.call_audit.LookupR\x04rule\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x1a\n" +
+	"\brequests\x18\x04 \x01(\x03R\brequests\x12#\n" +
+	"\rprompt_tokens\x18\x05 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x06 \x01(\x03R\x10completionTokens\x12\x12\n" +
+	"\x04cost\x18\a \x01(\x01R\x04cost\"\\\n" +
+	"\tUsageList\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.call_audit.UsageR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x03 \x01(\bR\x04next\"\xb1\x01\n" +
+	"\x12SearchUsageRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x17\n" +
+	"\arule_id\x18\x03 \x03(\x03R\x06ruleId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xcf\x01\n" +
+	"\x06Budget\x12#\n" +
+	"\rmonthly_limit\x18\x01 \x01(\x01R\fmonthlyLimit\x12\x14\n" +
+	"\x05spent\x18\x02 \x01(\x01R\x05spent\x12\x1c\n" +
+	"\texhausted\x18\x03 \x01(\bR\texhausted\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x121\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\v2\x12.call_audit.LookupR\tupdatedBy\"\x12\n" +
+	"\x10GetBudgetRequest\"7\n" +
+	"\x10SetBudgetRequest\x12#\n" +
+	"\rmonthly_limit\x18\x01 \x01(\x01R\fmonthlyLimit\"\x15\n" +
+	"\x13DeleteBudgetRequest2\xc0\x02\n" +
+	"\fUsageService\x12E\n" +
+	"\x06Search\x12\x1e.call_audit.SearchUsageRequest\x1a\x15.call_audit.UsageList\"\x04\x88\xb5\x18\x01\x12C\n" +
+	"\tGetBudget\x12\x1c.call_audit.GetBudgetRequest\x1a\x12.call_audit.Budget\"\x04\x88\xb5\x18\x01\x12C\n" +
+	"\tSetBudget\x12\x1c.call_audit.SetBudgetRequest\x1a\x12.call_audit.Budget\"\x04\x88\xb5\x18\x02\x12I\n" +
+	"\fDeleteBudget\x12\x1f.call_audit.DeleteBudgetRequest\x1a\x12.call_audit.Budget\"\x04\x88\xb5\x18\x02\x1a\x14\x8a\xb5\x18\x10call_audit_usageB\x8e\x01\n" +
+	"\x0ecom.call_auditB\n" +
+	"UsageProtoP\x01Z,github.com/webitel/call_audit/api/call_audit\xa2\x02\x03CXX\xaa\x02\tCallAudit\xca\x02\tCallAudit\xe2\x02\x15CallAudit\\GPBMetadata\xea\x02\tCallAuditb\x06proto3"
+
+var (
+	file_call_audit_usage_proto_rawDescOnce sync.Once
+	file_call_audit_usage_proto_rawDescData []byte
+)
+
+func file_call_audit_usage_proto_rawDescGZIP() []byte {
+	file_call_audit_usage_proto_rawDescOnce.Do(func() {
+		file_call_audit_usage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_call_audit_usage_proto_rawDesc), len(file_call_audit_usage_proto_rawDesc)))
+	})
+	return file_call_audit_usage_proto_rawDescData
+}
+
+var file_call_audit_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_call_audit_usage_proto_goTypes = []any{
+	(*Usage)(nil),                 // 0: call_audit.Usage
+	(*UsageList)(nil),             // 1: call_audit.UsageList
+	(*SearchUsageRequest)(nil),    // 2: call_audit.SearchUsageRequest
+	(*Budget)(nil),                // 3: call_audit.Budget
+	(*GetBudgetRequest)(nil),      // 4: call_audit.GetBudgetRequest
+	(*SetBudgetRequest)(nil),      // 5: call_audit.SetBudgetRequest
+	(*DeleteBudgetRequest)(nil),   // 6: call_audit.DeleteBudgetRequest
+	(*Lookup)(nil),                // 7: call_audit.Lookup
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_call_audit_usage_proto_depIdxs = []int32{
+	7,  // 0: call_audit.Usage.rule:type_name -> call_audit.Lookup
+	0,  // 1: call_audit.UsageList.items:type_name -> call_audit.Usage
+	8,  // 2: call_audit.SearchUsageRequest.from:type_name -> google.protobuf.Timestamp
+	8,  // 3: call_audit.SearchUsageRequest.to:type_name -> google.protobuf.Timestamp
+	8,  // 4: call_audit.Budget.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: call_audit.Budget.updated_by:type_name -> call_audit.Lookup
+	2,  // 6: call_audit.UsageService.Search:input_type -> call_audit.SearchUsageRequest
+	4,  // 7: call_audit.UsageService.GetBudget:input_type -> call_audit.GetBudgetRequest
+	5,  // 8: call_audit.UsageService.SetBudget:input_type -> call_audit.SetBudgetRequest
+	6,  // 9: call_audit.UsageService.DeleteBudget:input_type -> call_audit.DeleteBudgetRequest
+	1,  // 10: call_audit.UsageService.Search:output_type -> call_audit.UsageList
+	3,  // 11: call_audit.UsageService.GetBudget:output_type -> call_audit.Budget
+	3,  // 12: call_audit.UsageService.SetBudget:output_type -> call_audit.Budget
+	3,  // 13: call_audit.UsageService.DeleteBudget:output_type -> call_audit.Budget
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_call_audit_usage_proto_init() }
+func file_call_audit_usage_proto_init() {
+	if File_call_audit_usage_proto != nil {
+		return
+	}
+	file_call_audit_general_proto_init()
+	file_call_audit_options_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_call_audit_usage_proto_rawDesc), len(file_call_audit_usage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_call_audit_usage_proto_goTypes,
+		DependencyIndexes: file_call_audit_usage_proto_depIdxs,
+		MessageInfos:      file_call_audit_usage_proto_msgTypes,
+	}.Build()
+	File_call_audit_usage_proto = out.File
+	file_call_audit_usage_proto_goTypes = nil
+	file_call_audit_usage_proto_depIdxs = nil
+}
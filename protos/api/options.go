@@ -28,6 +28,106 @@ type HttpBinding struct {
 }
 
 var WebitelAPI = WebitelServicesInfo{
+	"AuditResultService": WebitelServices{
+		ObjClass:           "call_audit_results",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"Search": WebitelMethod{
+				Access: 1,
+				Input:  "SearchAuditResultsRequest",
+				Output: "AuditResultList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Get": WebitelMethod{
+				Access: 1,
+				Input:  "GetAuditResultRequest",
+				Output: "AuditResult",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+		},
+	},
+	"BackfillService": WebitelServices{
+		ObjClass:           "call_audit_backfills",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"Create": WebitelMethod{
+				Access: 0,
+				Input:  "CreateBackfillRequest",
+				Output: "Backfill",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Search": WebitelMethod{
+				Access: 1,
+				Input:  "SearchBackfillsRequest",
+				Output: "BackfillList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Get": WebitelMethod{
+				Access: 1,
+				Input:  "GetBackfillRequest",
+				Output: "Backfill",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Pause": WebitelMethod{
+				Access: 2,
+				Input:  "PauseBackfillRequest",
+				Output: "Backfill",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Resume": WebitelMethod{
+				Access: 2,
+				Input:  "ResumeBackfillRequest",
+				Output: "Backfill",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Cancel": WebitelMethod{
+				Access: 2,
+				Input:  "CancelBackfillRequest",
+				Output: "Backfill",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+		},
+	},
 	"CallQuestionnaireRuleService": WebitelServices{
 		ObjClass:           "",
 		AdditionalLicenses: []string{},
@@ -45,7 +145,7 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 			"List": WebitelMethod{
 				Access: 0,
-				Input:  "Empty",
+				Input:  "ListCallQuestionnaireRulesRequest",
 				Output: "CallQuestionnaireRuleList",
 				HttpBindings: []*HttpBinding{
 					{
@@ -76,6 +176,17 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
+			"Patch": WebitelMethod{
+				Access: 2,
+				Input:  "PatchCallQuestionnaireRuleRequest",
+				Output: "CallQuestionnaireRule",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
 			"Delete": WebitelMethod{
 				Access: 0,
 				Input:  "DeleteCallQuestionnaireRuleRequest",
@@ -87,6 +198,89 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
+			"PreviewRule": WebitelMethod{
+				Access: 2,
+				Input:  "PreviewCallQuestionnaireRuleRequest",
+				Output: "PreviewCallQuestionnaireRuleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+		},
+	},
+	"JobService": WebitelServices{
+		ObjClass:           "call_audit_jobs",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"Search": WebitelMethod{
+				Access: 1,
+				Input:  "SearchJobsRequest",
+				Output: "JobList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Get": WebitelMethod{
+				Access: 1,
+				Input:  "GetJobRequest",
+				Output: "Job",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Retry": WebitelMethod{
+				Access: 2,
+				Input:  "RetryJobRequest",
+				Output: "Job",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"Cancel": WebitelMethod{
+				Access: 2,
+				Input:  "CancelJobRequest",
+				Output: "Job",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"RetryFailed": WebitelMethod{
+				Access: 2,
+				Input:  "RetryFailedJobsRequest",
+				Output: "RetryFailedJobsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"AuditCalls": WebitelMethod{
+				Access: 0,
+				Input:  "AuditCallsRequest",
+				Output: "AuditCallsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
 		},
 	},
 	"LanguageProfileService": WebitelServices{
@@ -137,6 +331,17 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
+			"Patch": WebitelMethod{
+				Access: 2,
+				Input:  "PatchLanguageProfileRequest",
+				Output: "LanguageProfile",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
 			"Delete": WebitelMethod{
 				Access: 0,
 				Input:  "DeleteLanguageProfileRequest",
@@ -150,4 +355,54 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 		},
 	},
+	"UsageService": WebitelServices{
+		ObjClass:           "call_audit_usage",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"Search": WebitelMethod{
+				Access: 1,
+				Input:  "SearchUsageRequest",
+				Output: "UsageList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"GetBudget": WebitelMethod{
+				Access: 1,
+				Input:  "GetBudgetRequest",
+				Output: "Budget",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"SetBudget": WebitelMethod{
+				Access: 2,
+				Input:  "SetBudgetRequest",
+				Output: "Budget",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+			"DeleteBudget": WebitelMethod{
+				Access: 2,
+				Input:  "DeleteBudgetRequest",
+				Output: "Budget",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "",
+						Method: "",
+					},
+				},
+			},
+		},
+	},
 }
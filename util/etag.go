@@ -0,0 +1,43 @@
+package util
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Etag kinds, an etag of one object type is never accepted for another.
+const (
+	EtagCallQuestionnaireRule = "call_questionnaire_rule"
+	EtagLanguageProfile       = "language_profile"
+)
+
+var ErrInvalidEtag = errors.New("invalid etag")
+
+// EncodeEtag packs the object kind, id and row version into an opaque string.
+func EncodeEtag(kind string, id int64, ver int32) string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%s:%d:%d", kind, id, ver))
+}
+
+// DecodeEtag returns the id and row version of an etag produced by EncodeEtag for the same kind.
+func DecodeEtag(kind string, etag string) (int64, int32, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(etag)
+	if err != nil {
+		return 0, 0, ErrInvalidEtag
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[0] != kind {
+		return 0, 0, ErrInvalidEtag
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, ErrInvalidEtag
+	}
+	ver, err := strconv.ParseInt(parts[2], 10, 32)
+	if err != nil {
+		return 0, 0, ErrInvalidEtag
+	}
+	return id, int32(ver), nil
+}
@@ -0,0 +1,22 @@
+package util
+
+import "testing"
+
+func TestEtag(t *testing.T) {
+	tag := EncodeEtag(EtagCallQuestionnaireRule, 42, 7)
+
+	id, ver, err := DecodeEtag(EtagCallQuestionnaireRule, tag)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if id != 42 || ver != 7 {
+		t.Errorf("want 42/7, have %d/%d", id, ver)
+	}
+
+	if _, _, err := DecodeEtag(EtagLanguageProfile, tag); err == nil {
+		t.Error("etag of another kind must be rejected")
+	}
+	if _, _, err := DecodeEtag(EtagCallQuestionnaireRule, "not an etag"); err == nil {
+		t.Error("malformed etag must be rejected")
+	}
+}
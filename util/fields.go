@@ -73,21 +73,6 @@ func DeduplicateFields(in []string) []string {
 	return result
 }
 
-// AddVersionAndIdByEtag searches for etag, id, ver fields and determines what fields should be added
-// to provide full functionality of etag
-func AddVersionAndIdByEtag(fields []string) {
-	var hasEtag, hasId, hasVer bool
-	hasEtag, hasId, hasVer = FindEtagFields(fields)
-	if hasEtag {
-		if !hasId {
-			fields = append(fields, "id")
-		}
-		if !hasVer {
-			fields = append(fields, "ver")
-		}
-	}
-}
-
 func RemoveElements(arr []string, elementsToRemove ...string) []string {
 	elementSet := make(map[string]bool)
 	for _, elem := range elementsToRemove {
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// scheduleLookback are the periods searched for the last run of a schedule, from the most frequent schedules.
+var scheduleLookback = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	8 * 24 * time.Hour,
+	32 * 24 * time.Hour,
+	367 * 24 * time.Hour,
+}
+
+// ParseSchedule parses a standard five field cron spec, e.g. "0 2 * * *", or a descriptor like "@daily".
+func ParseSchedule(spec string) (cron.Schedule, error) {
+	return cron.ParseStandard(spec)
+}
+
+// LastScheduled returns the last run of the schedule at or before now, evaluated in the location of now.
+// It returns the zero time when the schedule has not run within the last year.
+func LastScheduled(schedule cron.Schedule, now time.Time) time.Time {
+	for _, lookback := range scheduleLookback {
+		last := schedule.Next(now.Add(-lookback))
+		if last.IsZero() {
+			// the schedule never runs
+			return last
+		}
+		if last.After(now) {
+			continue
+		}
+		for next := schedule.Next(last); !next.After(now); next = schedule.Next(next) {
+			last = next
+		}
+		return last
+	}
+	return time.Time{}
+}
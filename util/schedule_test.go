@@ -0,0 +1,78 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLastScheduled(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	tests := []struct {
+		name string
+		spec string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "nightly before the run",
+			spec: "0 2 * * *",
+			now:  time.Date(2025, 3, 12, 1, 59, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 11, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "nightly at the run",
+			spec: "0 2 * * *",
+			now:  time.Date(2025, 3, 12, 2, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 12, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "every minute",
+			spec: "* * * * *",
+			now:  time.Date(2025, 3, 12, 10, 30, 45, 0, time.UTC),
+			want: time.Date(2025, 3, 12, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "weekdays only",
+			spec: "0 2 * * 1-5",
+			now:  time.Date(2025, 3, 16, 12, 0, 0, 0, time.UTC), // Sunday
+			want: time.Date(2025, 3, 14, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "yearly",
+			spec: "@yearly",
+			now:  time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "location of now",
+			spec: "0 2 * * *",
+			now:  time.Date(2025, 3, 12, 1, 0, 0, 0, time.UTC).In(kyiv), // 03:00 in Kyiv
+			want: time.Date(2025, 3, 12, 2, 0, 0, 0, kyiv),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q) error: %v", tt.spec, err)
+			}
+			if got := LastScheduled(schedule, tt.now); !got.Equal(tt.want) {
+				t.Errorf("LastScheduled(%q, %v) = %v, want %v", tt.spec, tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastScheduled_NotRun(t *testing.T) {
+	// February 30th never comes
+	schedule, err := ParseSchedule("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("ParseSchedule error: %v", err)
+	}
+	if got := LastScheduled(schedule, time.Now()); !got.IsZero() {
+		t.Errorf("LastScheduled() = %v, want zero", got)
+	}
+}